@@ -0,0 +1,4 @@
+data "metabase_database" "warehouse" {
+  name   = "Warehouse"
+  engine = "postgres"
+}
@@ -0,0 +1,15 @@
+data "metabase_database_metadata" "warehouse" {
+  database_id = metabase_database.warehouse.id
+  schema      = "public"
+}
+
+locals {
+  table_ids = { for table in data.metabase_database_metadata.warehouse.tables : table.name => table.id }
+}
+
+resource "metabase_field" "order_total" {
+  table_id = local.table_ids["orders"]
+  name     = "total"
+
+  semantic_type = "type/Currency"
+}
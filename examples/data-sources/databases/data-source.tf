@@ -0,0 +1,6 @@
+data "metabase_databases" "all" {}
+
+locals {
+  # Every database added by users, excluding the sample and audit databases
+  warehouse_ids = [for db in data.metabase_databases.all.databases : db.id if !db.is_sample && !db.is_audit]
+}
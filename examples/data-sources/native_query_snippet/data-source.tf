@@ -0,0 +1,3 @@
+data "metabase_native_query_snippet" "active_customers" {
+  name = "active_customers"
+}
@@ -0,0 +1,3 @@
+data "metabase_permissions_group" "analysts" {
+  name = "Analysts"
+}
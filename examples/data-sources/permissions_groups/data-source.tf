@@ -0,0 +1,6 @@
+data "metabase_permissions_groups" "all" {}
+
+locals {
+  all_users_group_id = data.metabase_permissions_groups.all.all_users_group_id
+  group_ids          = { for group in data.metabase_permissions_groups.all.groups : group.name => group.id }
+}
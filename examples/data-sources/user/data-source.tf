@@ -5,3 +5,8 @@ data "metabase_user" "example" {
 locals {
   user_email = data.metabase_user.example.email
 }
+
+data "metabase_user" "by_email" {
+  email               = "user@example.com"
+  include_deactivated = true
+}
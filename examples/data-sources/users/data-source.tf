@@ -0,0 +1,8 @@
+data "metabase_users" "analysts" {
+  email_domain = "example.com"
+  group_id     = metabase_permissions_group.analysts.id
+}
+
+locals {
+  analyst_emails = [for user in data.metabase_users.analysts.users : user.email]
+}
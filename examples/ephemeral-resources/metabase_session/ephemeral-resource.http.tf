@@ -0,0 +1,13 @@
+ephemeral "metabase_session" "this" {
+  username = "example@example.com"
+  password = var.metabase_password
+}
+
+# Use the session to make requests to the Metabase API directly
+data "http" "settings" {
+  url = "${ephemeral.metabase_session.this.host}/api/setting"
+
+  request_headers = {
+    X-Metabase-Session = ephemeral.metabase_session.this.token
+  }
+}
@@ -0,0 +1,8 @@
+ephemeral "metabase_session" "this" {}
+
+# Use the session to configure another provider
+provider "metabase" {
+  alias         = "session"
+  host          = ephemeral.metabase_session.this.host
+  session_token = ephemeral.metabase_session.this.token
+}
@@ -0,0 +1,20 @@
+resource "metabase_action" "archive_products" {
+  model_id = 1
+  name     = "Archive products"
+  type     = "query"
+
+  dataset_query = provider::metabase::native_query(
+    metabase_database.example.id,
+    "UPDATE products SET archived = true WHERE {{category}}",
+    {
+      category = {
+        id           = "0c4b7e2a-5f1d-4b3e-8a6c-9d2e1f0a7b35"
+        name         = "category"
+        display-name = "Category"
+        type         = "dimension"
+        widget-type  = "string/="
+        dimension    = provider::metabase::mbql_field_ref(12)
+      }
+    },
+  )
+}
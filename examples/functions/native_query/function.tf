@@ -0,0 +1,18 @@
+resource "metabase_action" "cancel_order" {
+  model_id = 1
+  name     = "Cancel order"
+  type     = "query"
+
+  dataset_query = provider::metabase::native_query(
+    metabase_database.example.id,
+    "UPDATE orders SET status = 'cancelled' WHERE id = {{order_id}}",
+    {
+      order_id = {
+        id           = "6a2f1c4e-0b5d-4d1a-9a7e-3c8b2f9d1e40"
+        name         = "order_id"
+        display-name = "Order ID"
+        type         = "number"
+      }
+    },
+  )
+}
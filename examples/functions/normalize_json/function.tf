@@ -0,0 +1,7 @@
+locals {
+  visualization_settings = provider::metabase::normalize_json(file("${path.module}/visualization_settings.json"))
+}
+
+output "visualization_settings" {
+  value = local.visualization_settings
+}
@@ -0,0 +1,19 @@
+variable "metabase_site_url" {
+  type = string
+}
+
+variable "embedding_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+output "customer_orders_url" {
+  sensitive = true
+  value = format("%s%s", var.metabase_site_url, provider::metabase::signed_embed_url(
+    "dashboard",
+    metabase_embedding.customer_orders.dashboard_id,
+    { customer_id = 42 },
+    var.embedding_secret_key,
+    null,
+  ))
+}
@@ -0,0 +1,3 @@
+list "metabase_api_key" "all" {
+  provider = metabase
+}
@@ -0,0 +1,7 @@
+list "metabase_database" "all" {
+  provider = metabase
+
+  config {
+    engine = "postgres"
+  }
+}
@@ -0,0 +1,7 @@
+list "metabase_field" "orders" {
+  provider = metabase
+
+  config {
+    table_id = 10
+  }
+}
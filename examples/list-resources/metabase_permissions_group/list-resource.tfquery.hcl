@@ -0,0 +1,3 @@
+list "metabase_permissions_group" "all" {
+  provider = metabase
+}
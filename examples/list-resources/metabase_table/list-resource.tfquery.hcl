@@ -0,0 +1,8 @@
+list "metabase_table" "warehouse" {
+  provider = metabase
+
+  config {
+    database_id = 2
+    schema      = "public"
+  }
+}
@@ -0,0 +1,8 @@
+list "metabase_user" "active" {
+  provider = metabase
+
+  config {
+    status       = "active"
+    email_domain = "example.com"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = metabase_action.example
+  identity = {
+    id = 1
+  }
+}
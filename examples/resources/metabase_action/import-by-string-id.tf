@@ -0,0 +1,4 @@
+import {
+  to = metabase_action.example
+  id = "1"
+}
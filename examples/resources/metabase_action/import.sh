@@ -0,0 +1 @@
+$ terraform import metabase_action.example 1
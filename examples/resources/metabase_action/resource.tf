@@ -0,0 +1,37 @@
+resource "metabase_action" "create_order" {
+  model_id = 1
+  name     = "Create order"
+  type     = "implicit"
+  kind     = "row/create"
+}
+
+resource "metabase_action" "cancel_order" {
+  model_id    = 1
+  name        = "Cancel order"
+  description = "Marks the order as cancelled"
+  type        = "query"
+
+  dataset_query = jsonencode({
+    database = metabase_database.example.id
+    type     = "native"
+    native = {
+      query = "UPDATE orders SET status = 'cancelled' WHERE id = {{order_id}}"
+      template-tags = {
+        order_id = {
+          id           = "6a2f1c4e-0b5d-4d1a-9a7e-3c8b2f9d1e40"
+          name         = "order_id"
+          display-name = "Order ID"
+          type         = "number"
+        }
+      }
+    }
+  })
+  parameters = jsonencode([
+    {
+      id     = "6a2f1c4e-0b5d-4d1a-9a7e-3c8b2f9d1e40"
+      slug   = "order_id"
+      type   = "number/="
+      target = ["variable", ["template-tag", "order_id"]]
+    }
+  ])
+}
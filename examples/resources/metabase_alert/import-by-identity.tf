@@ -0,0 +1,6 @@
+import {
+  to = metabase_alert.example
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = metabase_alert.example
+  id = "1"
+}
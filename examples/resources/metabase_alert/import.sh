@@ -0,0 +1 @@
+$ terraform import metabase_alert.example 1
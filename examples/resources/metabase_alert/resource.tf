@@ -0,0 +1,34 @@
+resource "metabase_user" "on_call" {
+  email      = "on-call@example.com"
+  first_name = "On"
+  last_name  = "Call"
+}
+
+resource "metabase_alert" "failed_payments" {
+  card_id         = 42
+  alert_condition = "rows"
+
+  email = {
+    user_ids = [metabase_user.on_call.id]
+  }
+
+  schedule = {
+    type = "hourly"
+  }
+}
+
+resource "metabase_alert" "revenue_target" {
+  card_id          = 43
+  alert_condition  = "goal"
+  alert_above_goal = true
+  alert_first_only = true
+
+  slack = {
+    channel = "#revenue"
+  }
+
+  schedule = {
+    type = "daily"
+    hour = 9
+  }
+}
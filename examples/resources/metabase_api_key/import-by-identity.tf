@@ -0,0 +1,6 @@
+import {
+  to = metabase_api_key.example
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = metabase_api_key.example
+  id = "1"
+}
@@ -0,0 +1 @@
+$ terraform import metabase_api_key.example 1
@@ -0,0 +1,13 @@
+resource "metabase_permissions_group" "integrations" {
+  name = "Integrations"
+}
+
+resource "metabase_api_key" "example" {
+  name     = "Example integration"
+  group_id = metabase_permissions_group.integrations.id
+
+  # Change any of these values to regenerate the key
+  rotation_triggers = {
+    rotated = "2024-01-01"
+  }
+}
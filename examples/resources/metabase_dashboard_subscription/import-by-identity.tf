@@ -0,0 +1,6 @@
+import {
+  to = metabase_dashboard_subscription.example
+  identity = {
+    id = 1
+  }
+}
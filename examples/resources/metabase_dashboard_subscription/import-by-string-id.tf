@@ -0,0 +1,4 @@
+import {
+  to = metabase_dashboard_subscription.example
+  id = "1"
+}
@@ -0,0 +1 @@
+$ terraform import metabase_dashboard_subscription.example 1
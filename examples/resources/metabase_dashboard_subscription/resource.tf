@@ -0,0 +1,40 @@
+resource "metabase_user" "finance" {
+  email      = "finance@example.com"
+  first_name = "Finance"
+  last_name  = "Team"
+}
+
+resource "metabase_dashboard_subscription" "example" {
+  dashboard_id = 1
+  name         = "Weekly revenue"
+
+  cards = [
+    {
+      card_id           = 10
+      dashboard_card_id = 20
+      include_csv       = true
+    },
+  ]
+
+  email = {
+    user_ids  = [metabase_user.finance.id]
+    addresses = ["board@example.com"]
+  }
+  slack = {
+    channel = "#revenue"
+  }
+
+  schedule = {
+    type = "weekly"
+    day  = "mon"
+    hour = 8
+  }
+
+  skip_if_empty = true
+  parameters = jsonencode([
+    {
+      id    = "a1b2c3d4"
+      value = ["EMEA"]
+    },
+  ])
+}
@@ -0,0 +1,6 @@
+import {
+  to = metabase_database.example
+  identity = {
+    id = 1
+  }
+}
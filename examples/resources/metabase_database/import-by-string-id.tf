@@ -0,0 +1,4 @@
+import {
+  to = metabase_database.example
+  id = "Sales"
+}
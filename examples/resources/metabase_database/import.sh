@@ -1 +1,5 @@
+# Import a database by its ID
 $ terraform import metabase_database.example 1
+
+# Import a database by its name
+$ terraform import metabase_database.example "Sales"
@@ -11,4 +11,6 @@ resource "metabase_database" "example" {
   details_secure = jsonencode({
     password = "password"
   })
+
+  actions_enabled = true
 }
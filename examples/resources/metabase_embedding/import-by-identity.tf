@@ -0,0 +1,7 @@
+import {
+  to = metabase_embedding.example
+  identity = {
+    object_type = "dashboard"
+    id          = 1
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = metabase_embedding.example
+  id = "dashboard/1"
+}
@@ -0,0 +1 @@
+$ terraform import metabase_embedding.example dashboard/1
@@ -0,0 +1,11 @@
+resource "metabase_embedding" "customer_orders" {
+  dashboard_id = 2
+
+  embedding_params = {
+    customer_id = "locked"
+    created_at  = "enabled"
+    category    = "disabled"
+  }
+
+  depends_on = [metabase_embedding_settings.this]
+}
@@ -0,0 +1,9 @@
+variable "embedding_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "metabase_embedding_settings" "this" {
+  embedding_secret_key         = var.embedding_secret_key
+  embedding_secret_key_version = "2024-01-01"
+}
@@ -0,0 +1,6 @@
+import {
+  to = metabase_field.example
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = metabase_field.example
+  id = "1"
+}
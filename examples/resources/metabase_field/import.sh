@@ -0,0 +1 @@
+$ terraform import metabase_field.example 1
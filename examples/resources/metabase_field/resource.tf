@@ -0,0 +1,36 @@
+resource "metabase_field" "customer_email" {
+  table_id = metabase_table.customers.id
+  name     = "email"
+
+  display_name    = "Email address"
+  description     = "The customer's primary email address."
+  semantic_type   = "type/Email"
+  visibility_type = "sensitive"
+}
+
+resource "metabase_field" "order_customer_id" {
+  table_id = metabase_table.orders.id
+  name     = "customer_id"
+
+  semantic_type      = "type/FK"
+  fk_target_field_id = metabase_field.customer_id.id
+
+  # Display the customer's name instead of their ID
+  dimension = {
+    type                    = "external"
+    name                    = "Customer"
+    human_readable_field_id = metabase_field.customer_name.id
+  }
+}
+
+resource "metabase_field" "order_total" {
+  table_id = metabase_table.orders.id
+  name     = "total"
+
+  semantic_type    = "type/Currency"
+  has_field_values = "none"
+  settings = jsonencode({
+    currency       = "GBP"
+    currency_style = "symbol"
+  })
+}
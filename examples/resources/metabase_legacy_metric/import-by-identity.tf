@@ -0,0 +1,6 @@
+import {
+  to = metabase_legacy_metric.example
+  identity = {
+    id = 1
+  }
+}
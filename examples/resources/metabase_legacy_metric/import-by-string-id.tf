@@ -0,0 +1,4 @@
+import {
+  to = metabase_legacy_metric.example
+  id = "1"
+}
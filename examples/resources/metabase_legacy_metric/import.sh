@@ -0,0 +1 @@
+$ terraform import metabase_legacy_metric.example 1
@@ -0,0 +1,23 @@
+data "metabase_database_metadata" "sample" {
+  database_id = 1
+  schema      = "PUBLIC"
+  table_name  = "ORDERS"
+}
+
+locals {
+  orders   = data.metabase_database_metadata.sample.tables[0]
+  quantity = one([for field in local.orders.fields : field.id if field.name == "QUANTITY"])
+}
+
+resource "metabase_legacy_metric" "items_sold" {
+  name        = "Items sold"
+  description = "The total number of items ordered."
+  table_id    = local.orders.id
+  definition = jsonencode({
+    "source-table" = local.orders.id
+    aggregation    = [["sum", ["field", local.quantity, null]]]
+  })
+
+  # Required by Metabase whenever the metric is changed
+  revision_message = "Initial definition"
+}
@@ -0,0 +1,6 @@
+import {
+  to = metabase_native_query_snippet.example
+  identity = {
+    id = 1
+  }
+}
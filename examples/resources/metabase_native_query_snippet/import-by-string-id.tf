@@ -0,0 +1,4 @@
+import {
+  to = metabase_native_query_snippet.example
+  id = "1"
+}
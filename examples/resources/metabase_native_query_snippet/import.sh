@@ -0,0 +1 @@
+$ terraform import metabase_native_query_snippet.example 1
@@ -0,0 +1,8 @@
+resource "metabase_native_query_snippet" "active_customers" {
+  name        = "active_customers"
+  description = "Customers who are active and haven't been deleted. Use as {{snippet: active_customers}}."
+  content     = <<-EOT
+    status = 'active'
+      AND deleted_at IS NULL
+  EOT
+}
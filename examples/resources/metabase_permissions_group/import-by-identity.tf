@@ -0,0 +1,6 @@
+import {
+  to = metabase_permissions_group.example
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = metabase_permissions_group.example
+  id = "Analysts"
+}
@@ -1 +1,5 @@
+# Import a permissions group by its ID
 $ terraform import metabase_permissions_group.example 1
+
+# Import a permissions group by its name
+$ terraform import metabase_permissions_group.example "Analysts"
@@ -0,0 +1,7 @@
+import {
+  to = metabase_public_link.example
+  identity = {
+    object_type = "dashboard"
+    id          = 1
+  }
+}
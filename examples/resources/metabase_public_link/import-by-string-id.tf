@@ -0,0 +1,4 @@
+import {
+  to = metabase_public_link.example
+  id = "dashboard/1"
+}
@@ -0,0 +1 @@
+$ terraform import metabase_public_link.example dashboard/1
@@ -0,0 +1,7 @@
+resource "metabase_public_link" "sales_dashboard" {
+  dashboard_id = 1
+}
+
+output "sales_dashboard_url" {
+  value = metabase_public_link.sales_dashboard.public_url
+}
@@ -0,0 +1,6 @@
+import {
+  to = metabase_segment.example
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = metabase_segment.example
+  id = "1"
+}
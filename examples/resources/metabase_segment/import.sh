@@ -0,0 +1 @@
+$ terraform import metabase_segment.example 1
@@ -0,0 +1,23 @@
+data "metabase_database_metadata" "sample" {
+  database_id = 1
+  schema      = "PUBLIC"
+  table_name  = "ORDERS"
+}
+
+locals {
+  orders   = data.metabase_database_metadata.sample.tables[0]
+  quantity = one([for field in local.orders.fields : field.id if field.name == "QUANTITY"])
+}
+
+resource "metabase_segment" "bulk_orders" {
+  name        = "Bulk orders"
+  description = "Orders of more than 10 items."
+  table_id    = local.orders.id
+  definition = jsonencode({
+    "source-table" = local.orders.id
+    filter         = [">", ["field", local.quantity, null], 10]
+  })
+
+  # Required by Metabase whenever the segment is changed
+  revision_message = "Lowered the threshold to 10 items"
+}
@@ -0,0 +1,10 @@
+variable "slack_app_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "metabase_slack_settings" "this" {
+  app_token          = var.slack_app_token
+  app_token_version  = "2024-01-01"
+  bug_report_channel = "metabase-bugs"
+}
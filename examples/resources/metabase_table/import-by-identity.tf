@@ -0,0 +1,6 @@
+import {
+  to = metabase_table.example
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = metabase_table.example
+  id = "1"
+}
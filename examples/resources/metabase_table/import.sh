@@ -0,0 +1 @@
+$ terraform import metabase_table.example 1
@@ -0,0 +1,19 @@
+resource "metabase_table" "orders" {
+  database_id = metabase_database.warehouse.id
+  schema      = "public"
+  name        = "orders"
+
+  display_name = "Orders"
+  description  = "One row per order placed through the website."
+  caveats      = "Orders placed before 2020 are not included."
+  entity_type  = "entity/TransactionTable"
+  field_order  = "smart"
+}
+
+resource "metabase_table" "audit_log" {
+  database_id = metabase_database.warehouse.id
+  schema      = "public"
+  name        = "audit_log"
+
+  visibility_type = "technical"
+}
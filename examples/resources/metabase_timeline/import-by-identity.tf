@@ -0,0 +1,6 @@
+import {
+  to = metabase_timeline.example
+  identity = {
+    id = 1
+  }
+}
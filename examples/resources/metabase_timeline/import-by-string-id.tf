@@ -0,0 +1,4 @@
+import {
+  to = metabase_timeline.example
+  id = "1"
+}
@@ -0,0 +1 @@
+$ terraform import metabase_timeline.example 1
@@ -0,0 +1,5 @@
+resource "metabase_timeline" "releases" {
+  name        = "Product releases"
+  description = "Major releases of the product"
+  icon        = "balloons"
+}
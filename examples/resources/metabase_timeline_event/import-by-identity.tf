@@ -0,0 +1,6 @@
+import {
+  to = metabase_timeline_event.example
+  identity = {
+    id = 1
+  }
+}
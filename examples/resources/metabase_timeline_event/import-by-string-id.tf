@@ -0,0 +1,4 @@
+import {
+  to = metabase_timeline_event.example
+  id = "1"
+}
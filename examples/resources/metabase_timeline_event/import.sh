@@ -0,0 +1 @@
+$ terraform import metabase_timeline_event.example 1
@@ -0,0 +1,8 @@
+resource "metabase_timeline_event" "v2_launch" {
+  timeline_id  = metabase_timeline.releases.id
+  name         = "v2.0 launch"
+  description  = "General availability of v2.0"
+  timestamp    = "2026-03-01T09:00:00Z"
+  time_matters = true
+  timezone     = "Europe/London"
+}
@@ -0,0 +1,6 @@
+import {
+  to = metabase_user.example
+  identity = {
+    email = "user@example.com"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = metabase_user.example
+  id = "user@example.com"
+}
@@ -1 +1,5 @@
+# Import a user by their ID
 $ terraform import metabase_user.example 1
+
+# Import a user by their email address
+$ terraform import metabase_user.example user@example.com
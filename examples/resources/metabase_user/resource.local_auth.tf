@@ -0,0 +1,19 @@
+variable "service_account_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "metabase_user" "service_account" {
+  email      = "reports@example.com"
+  first_name = "Reporting"
+  last_name  = "Service"
+
+  password         = var.service_account_password
+  password_version = "1"
+
+  # Leave the account in place if it is removed from Terraform, and don't silently re-enable it if someone deactivates
+  # it in Metabase
+  on_destroy          = "keep"
+  reactivate_on_drift = false
+}
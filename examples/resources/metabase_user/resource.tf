@@ -2,5 +2,15 @@ resource "metabase_user" "example" {
   email      = "email@example.com"
   first_name = "Example"
   last_name  = "User"
-  group_ids  = [3, 4]
+  locale     = "en_GB"
+
+  group_memberships = [
+    { group_id = 3 },
+    { group_id = 4, is_group_manager = true },
+  ]
+
+  # Used by data sandboxing and connection impersonation
+  login_attributes = {
+    region = "emea"
+  }
 }
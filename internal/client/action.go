@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	ActionTypeImplicit = "implicit"
+	ActionTypeQuery    = "query"
+	ActionTypeHttp     = "http"
+)
+
+type ActionService struct {
+	client *Client
+}
+
+// Action represents an action on a model, which allows users to write data back to the model's database. Implicit
+// actions create, update or delete rows in the model's table, query actions run a custom native query and HTTP actions
+// call an external endpoint.
+type Action struct {
+	Id                    int64            `json:"id"`
+	Name                  string           `json:"name"`
+	Description           *string          `json:"description"`
+	Type                  string           `json:"type"`
+	ModelId               int64            `json:"model_id"`
+	Kind                  *string          `json:"kind"`
+	DatabaseId            *int64           `json:"database_id"`
+	DatasetQuery          map[string]any   `json:"dataset_query"`
+	Template              map[string]any   `json:"template"`
+	Parameters            []map[string]any `json:"parameters"`
+	VisualizationSettings map[string]any   `json:"visualization_settings"`
+	Archived              bool             `json:"archived"`
+	CreatorId             int64            `json:"creator_id"`
+	CreatedAt             string           `json:"created_at"`
+	UpdatedAt             string           `json:"updated_at"`
+}
+
+// ActionRequest represents the request body used to create or update an action. Only the fields relevant to the type
+// of action should be provided.
+type ActionRequest struct {
+	Name                  string           `json:"name"`
+	Description           *string          `json:"description"`
+	Type                  string           `json:"type"`
+	ModelId               int64            `json:"model_id"`
+	Kind                  *string          `json:"kind,omitempty"`
+	DatabaseId            *int64           `json:"database_id,omitempty"`
+	DatasetQuery          map[string]any   `json:"dataset_query,omitempty"`
+	Template              map[string]any   `json:"template,omitempty"`
+	Parameters            []map[string]any `json:"parameters,omitempty"`
+	VisualizationSettings map[string]any   `json:"visualization_settings,omitempty"`
+	Archived              *bool            `json:"archived,omitempty"`
+}
+
+// Create creates a new action.
+func (s *ActionService) Create(ctx context.Context, request *ActionRequest) (*Action, error) {
+	var resp Action
+	err := s.client.Post(ctx, "/action", request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error creating action: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// Get fetches the details of an existing action. Archived actions are still returned.
+func (s *ActionService) Get(ctx context.Context, id int64) (*Action, error) {
+	var resp Action
+	err := s.client.Get(ctx, fmt.Sprintf("/action/%d", id), &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching action %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// Update updates an existing action. The type of an action cannot be changed.
+func (s *ActionService) Update(ctx context.Context, id int64, request *ActionRequest) (*Action, error) {
+	var resp Action
+	err := s.client.Put(ctx, fmt.Sprintf("/action/%d", id), request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error updating action %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// Delete deletes an existing action.
+func (s *ActionService) Delete(ctx context.Context, id int64) error {
+	err := s.client.Delete(ctx, fmt.Sprintf("/action/%d", id), nil)
+	if err != nil {
+		return fmt.Errorf("error deleting action %d: %w", id, err)
+	}
+
+	return nil
+}
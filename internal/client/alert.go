@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	AlertConditionRows = "rows"
+	AlertConditionGoal = "goal"
+)
+
+type AlertService struct {
+	client *Client
+}
+
+// Alert represents an alert on a card, which notifies a set of channels when the card has results or reaches its goal.
+type Alert struct {
+	Id             int64          `json:"id"`
+	Card           AlertCard      `json:"card"`
+	AlertCondition string         `json:"alert_condition"`
+	AlertFirstOnly bool           `json:"alert_first_only"`
+	AlertAboveGoal *bool          `json:"alert_above_goal"`
+	Channels       []PulseChannel `json:"channels"`
+	Archived       bool           `json:"archived"`
+	CreatorId      int64          `json:"creator_id"`
+	CreatedAt      string         `json:"created_at"`
+	UpdatedAt      string         `json:"updated_at"`
+}
+
+type AlertCard struct {
+	Id         int64 `json:"id"`
+	IncludeCsv bool  `json:"include_csv"`
+	IncludeXls bool  `json:"include_xls"`
+}
+
+// AlertRequest represents the request body used to create or update an alert.
+type AlertRequest struct {
+	Card           AlertCard      `json:"card"`
+	AlertCondition string         `json:"alert_condition"`
+	AlertFirstOnly bool           `json:"alert_first_only"`
+	AlertAboveGoal *bool          `json:"alert_above_goal"`
+	Channels       []PulseChannel `json:"channels"`
+}
+
+// Create creates a new alert.
+func (s *AlertService) Create(ctx context.Context, request *AlertRequest) (*Alert, error) {
+	var resp Alert
+	err := s.client.Post(ctx, "/alert", request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error creating alert: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// Get fetches the details of an existing alert. Archived alerts are still returned.
+func (s *AlertService) Get(ctx context.Context, id int64) (*Alert, error) {
+	var resp Alert
+	err := s.client.Get(ctx, fmt.Sprintf("/alert/%d", id), &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching alert %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// Update updates an existing alert, replacing its channels.
+func (s *AlertService) Update(ctx context.Context, id int64, request *AlertRequest) (*Alert, error) {
+	var resp Alert
+	err := s.client.Put(ctx, fmt.Sprintf("/alert/%d", id), request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error updating alert %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// Archive archives an existing alert. The API does not support deleting alerts, so this is the closest equivalent.
+func (s *AlertService) Archive(ctx context.Context, id int64) error {
+	err := s.client.Put(ctx, fmt.Sprintf("/alert/%d", id), map[string]bool{"archived": true}, nil)
+	if err != nil {
+		return fmt.Errorf("error archiving alert %d: %w", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+type ApiKeyService struct {
+	client *Client
+}
+
+// ApiKey represents the details of an existing API key returned from the Metabase API. The UnmaskedKey is only
+// returned when the key is created or regenerated.
+type ApiKey struct {
+	Id          int64        `json:"id"`
+	Name        string       `json:"name"`
+	Group       ApiKeyGroup  `json:"group"`
+	MaskedKey   string       `json:"masked_key"`
+	UnmaskedKey *string      `json:"unmasked_key"`
+	UpdatedBy   *ApiKeyActor `json:"updated_by"`
+	CreatedAt   string       `json:"created_at"`
+	UpdatedAt   string       `json:"updated_at"`
+}
+
+type ApiKeyGroup struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type ApiKeyActor struct {
+	Id         int64  `json:"id"`
+	CommonName string `json:"common_name"`
+}
+
+// CreateApiKeyRequest represents the request body used to create a new API key.
+type CreateApiKeyRequest struct {
+	Name    string `json:"name"`
+	GroupId int64  `json:"group_id"`
+}
+
+// UpdateApiKeyRequest represents the request body used to update an existing API key.
+type UpdateApiKeyRequest struct {
+	Name    *string `json:"name,omitempty"`
+	GroupId *int64  `json:"group_id,omitempty"`
+}
+
+// Create creates a new API key. The returned key is the only time the unmasked key is available.
+func (s *ApiKeyService) Create(ctx context.Context, request *CreateApiKeyRequest) (*ApiKey, error) {
+	var resp ApiKey
+	err := s.client.Post(ctx, "/api-key", request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API key: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// List fetches the details of all API keys.
+func (s *ApiKeyService) List(ctx context.Context) ([]ApiKey, error) {
+	var resp []ApiKey
+	err := s.client.Get(ctx, "/api-key", &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error listing API keys: %w", err)
+	}
+
+	return resp, nil
+}
+
+// Get fetches the details of an existing API key. The API does not support fetching a single key, so this searches the
+// list of all keys.
+func (s *ApiKeyService) Get(ctx context.Context, id int64) (*ApiKey, error) {
+	keys, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		if key.Id == id {
+			return &key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("error fetching API key %d: %w", id, ErrNotFound)
+}
+
+// Update updates the name and/or group of an existing API key.
+func (s *ApiKeyService) Update(ctx context.Context, id int64, request *UpdateApiKeyRequest) error {
+	err := s.client.Put(ctx, fmt.Sprintf("/api-key/%d", id), request, nil)
+	if err != nil {
+		return fmt.Errorf("error updating API key %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// Regenerate replaces the key of an existing API key, invalidating the old one. The returned key is the only time the
+// new unmasked key is available. The response only contains the ID, prefix and masked and unmasked keys.
+func (s *ApiKeyService) Regenerate(ctx context.Context, id int64) (*ApiKey, error) {
+	var resp ApiKey
+	err := s.client.Put(ctx, fmt.Sprintf("/api-key/%d/regenerate", id), nil, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error regenerating API key %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// Delete deletes an existing API key.
+func (s *ApiKeyService) Delete(ctx context.Context, id int64) error {
+	err := s.client.Delete(ctx, fmt.Sprintf("/api-key/%d", id), nil)
+	if err != nil {
+		return fmt.Errorf("error deleting API key %d: %w", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+type CardService struct {
+	sharingService
+}
+
+// Card represents the details of a saved question or model returned from the Metabase API.
+type Card struct {
+	Id                    int64          `json:"id"`
+	Name                  string         `json:"name"`
+	Display               string         `json:"display"`
+	Type                  string         `json:"type"`
+	CollectionId          *int64         `json:"collection_id"`
+	VisualizationSettings map[string]any `json:"visualization_settings"`
+	Archived              bool           `json:"archived"`
+}
+
+// Get fetches the details of an existing card.
+func (s *CardService) Get(ctx context.Context, id int64) (*Card, error) {
+	var resp Card
+	err := s.client.Get(ctx, fmt.Sprintf("/card/%d", id), &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching card %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
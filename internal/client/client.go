@@ -0,0 +1,170 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/bnjns/metabase-sdk-go/metabase"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+const sessionIdHeader = "X-Metabase-Session"
+
+var ErrNotFound = errors.New("not found")
+
+var disallowedAdditionalHeaders = []string{
+	"content-type",
+	"x-api-key",
+	strings.ToLower(sessionIdHeader),
+}
+
+// Client is a lightweight client for the parts of the Metabase API which are not yet covered by the SDK. It
+// authenticates using the same authenticator as the SDK client, so it must only be created once that client has been
+// initialised.
+type Client struct {
+	baseUrl           string
+	baseClient        *http.Client
+	authenticator     metabase.Authenticator
+	additionalHeaders map[string]string
+
+	Action             *ActionService
+	Alert              *AlertService
+	ApiKey             *ApiKeyService
+	Card               *CardService
+	Dashboard          *DashboardService
+	Database           *DatabaseService
+	Field              *FieldService
+	LegacyMetric       *LegacyMetricService
+	NativeQuerySnippet *NativeQuerySnippetService
+	Permissions        *PermissionsService
+	Pulse              *PulseService
+	Segment            *SegmentService
+	Session            *SessionService
+	Setting            *SettingService
+	Slack              *SlackService
+	Table              *TableService
+	Timeline           *TimelineService
+	TimelineEvent      *TimelineEventService
+	User               *UserService
+}
+
+// New returns an initialised Client which will communicate with the given host.
+func New(host string, authenticator metabase.Authenticator, headers map[string]string) *Client {
+	c := &Client{
+		baseUrl: strings.TrimSuffix(host, "/"),
+		baseClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		authenticator:     authenticator,
+		additionalHeaders: headers,
+	}
+
+	c.Action = &ActionService{client: c}
+	c.Alert = &AlertService{client: c}
+	c.ApiKey = &ApiKeyService{client: c}
+	c.Card = &CardService{sharingService{client: c, path: "/card", noun: "card"}}
+	c.Dashboard = &DashboardService{sharingService{client: c, path: "/dashboard", noun: "dashboard"}}
+	c.Database = &DatabaseService{client: c}
+	c.Field = &FieldService{client: c}
+	c.LegacyMetric = &LegacyMetricService{tableDefinitionService{client: c, path: "/legacy-metric", noun: "legacy metric"}}
+	c.NativeQuerySnippet = &NativeQuerySnippetService{client: c}
+	c.Permissions = &PermissionsService{client: c}
+	c.Pulse = &PulseService{client: c}
+	c.Segment = &SegmentService{tableDefinitionService{client: c, path: "/segment", noun: "segment"}}
+	c.Session = &SessionService{client: c}
+	c.Setting = &SettingService{client: c}
+	c.Slack = &SlackService{client: c}
+	c.Table = &TableService{client: c}
+	c.Timeline = &TimelineService{client: c}
+	c.TimelineEvent = &TimelineEventService{client: c}
+	c.User = &UserService{client: c}
+
+	return c
+}
+
+func (c *Client) buildUrl(path string) string {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return fmt.Sprintf("%s/api%s", c.baseUrl, path)
+}
+
+// do sends a request to the API and unmarshalls the response body into response, if provided. The authenticate
+// function is used to add the authentication details to the request, and can be nil for unauthenticated requests.
+func (c *Client) do(ctx context.Context, method string, path string, body interface{}, response interface{}, authenticate func(request *http.Request)) error {
+	var bodyReader io.Reader
+	if body != nil {
+		reqBody, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshalling request to JSON: %w", err)
+		}
+		bodyReader = bytes.NewBuffer(reqBody)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, c.buildUrl(path), bodyReader)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	for k, v := range c.additionalHeaders {
+		if !slices.Contains(disallowedAdditionalHeaders, strings.ToLower(k)) {
+			request.Header.Set(k, v)
+		}
+	}
+	if authenticate != nil {
+		authenticate(request)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	res, err := c.baseClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return errors.New(string(resBody))
+	}
+
+	if response != nil && len(resBody) > 0 {
+		if err := json.Unmarshal(resBody, response); err != nil {
+			return fmt.Errorf("error unmarshalling response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) authenticate(request *http.Request) {
+	c.authenticator.OnRequest(request)
+}
+
+func (c *Client) Get(ctx context.Context, path string, response interface{}) error {
+	return c.do(ctx, http.MethodGet, path, nil, response, c.authenticate)
+}
+
+func (c *Client) Post(ctx context.Context, path string, request interface{}, response interface{}) error {
+	return c.do(ctx, http.MethodPost, path, request, response, c.authenticate)
+}
+
+func (c *Client) Put(ctx context.Context, path string, request interface{}, response interface{}) error {
+	return c.do(ctx, http.MethodPut, path, request, response, c.authenticate)
+}
+
+func (c *Client) Delete(ctx context.Context, path string, response interface{}) error {
+	return c.do(ctx, http.MethodDelete, path, nil, response, c.authenticate)
+}
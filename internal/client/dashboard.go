@@ -0,0 +1,6 @@
+package client
+
+// DashboardService currently only supports sharing dashboards, as dashboards aren't otherwise managed by the provider.
+type DashboardService struct {
+	sharingService
+}
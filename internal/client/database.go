@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"github.com/bnjns/metabase-sdk-go/service/database"
+)
+
+// DatabaseSettingEnableActions is the database setting which controls whether actions can be run against the database.
+const DatabaseSettingEnableActions = "database-enable-actions"
+
+type DatabaseService struct {
+	client *Client
+}
+
+// Database represents a database returned when listing databases, which includes details the SDK does not support.
+// The settings replace those of the SDK, which only supports string values.
+type Database struct {
+	database.Database
+	IsAudit  bool           `json:"is_audit"`
+	Settings map[string]any `json:"settings"`
+}
+
+// ActionsEnabled returns whether actions have been enabled for the database.
+func (d *Database) ActionsEnabled() bool {
+	enabled, _ := d.Settings[DatabaseSettingEnableActions].(bool)
+	return enabled
+}
+
+type listDatabasesResponse struct {
+	Data []Database `json:"data"`
+}
+
+// DatabaseMetadata represents the metadata of a database, including all of its tables and their fields.
+type DatabaseMetadata struct {
+	Id     int64   `json:"id"`
+	Name   string  `json:"name"`
+	Engine string  `json:"engine"`
+	Tables []Table `json:"tables"`
+}
+
+// Get fetches the details of an existing database.
+func (s *DatabaseService) Get(ctx context.Context, id int64) (*Database, error) {
+	var resp Database
+	err := s.client.Get(ctx, fmt.Sprintf("/database/%d", id), &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching database %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// UpdateSettings replaces the settings of an existing database, so should include any existing settings which are to be
+// retained.
+func (s *DatabaseService) UpdateSettings(ctx context.Context, id int64, settings map[string]any) error {
+	err := s.client.Put(ctx, fmt.Sprintf("/database/%d", id), map[string]any{"settings": settings}, nil)
+	if err != nil {
+		return fmt.Errorf("error updating settings for database %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// GetMetadata fetches the metadata of all the tables (including hidden tables) and fields in a database.
+func (s *DatabaseService) GetMetadata(ctx context.Context, id int64) (*DatabaseMetadata, error) {
+	var resp DatabaseMetadata
+	err := s.client.Get(ctx, fmt.Sprintf("/database/%d/metadata?include_hidden=true", id), &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching metadata for database %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// List fetches the details of all databases, including the sample and audit (Usage analytics) databases.
+func (s *DatabaseService) List(ctx context.Context) ([]Database, error) {
+	var resp listDatabasesResponse
+	err := s.client.Get(ctx, "/database?include_analytics=true", &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error listing databases: %w", err)
+	}
+
+	return resp.Data, nil
+}
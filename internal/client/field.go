@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type FieldService struct {
+	client *Client
+}
+
+// Field represents the metadata of a field (column) which has been synced from a database.
+type Field struct {
+	Id               int64                   `json:"id"`
+	TableId          int64                   `json:"table_id"`
+	Name             string                  `json:"name"`
+	DisplayName      string                  `json:"display_name"`
+	Description      *string                 `json:"description"`
+	BaseType         string                  `json:"base_type"`
+	SemanticType     *string                 `json:"semantic_type,omitempty"`
+	FkTargetFieldId  *int64                  `json:"fk_target_field_id,omitempty"`
+	VisibilityType   string                  `json:"visibility_type"`
+	HasFieldValues   string                  `json:"has_field_values"`
+	CoercionStrategy *string                 `json:"coercion_strategy"`
+	Settings         *map[string]interface{} `json:"settings"`
+	Dimensions       Dimensions              `json:"dimensions"`
+}
+
+// Dimension represents the remapping of a field's values, either to a set of custom values (internal) or to the values
+// of another field using a foreign key (external).
+type Dimension struct {
+	Id                   int64  `json:"id,omitempty"`
+	Type                 string `json:"type"`
+	Name                 string `json:"name"`
+	HumanReadableFieldId *int64 `json:"human_readable_field_id"`
+}
+
+// Dimensions are returned as either a list or a single object, depending on the version of Metabase.
+type Dimensions []Dimension
+
+func (d *Dimensions) UnmarshalJSON(data []byte) error {
+	var list []Dimension
+	if err := json.Unmarshal(data, &list); err == nil {
+		*d = list
+		return nil
+	}
+
+	var single *Dimension
+	if err := json.Unmarshal(data, &single); err != nil {
+		return err
+	}
+	if single != nil {
+		*d = []Dimension{*single}
+	} else {
+		*d = nil
+	}
+	return nil
+}
+
+// UpdateFieldRequest represents the request body used to update the metadata of a field. The fields which can be
+// cleared are always sent, whereas the others (including those inferred by the sync) are omitted if not set.
+type UpdateFieldRequest struct {
+	DisplayName      *string                 `json:"display_name,omitempty"`
+	Description      *string                 `json:"description"`
+	SemanticType     *string                 `json:"semantic_type,omitempty"`
+	FkTargetFieldId  *int64                  `json:"fk_target_field_id,omitempty"`
+	VisibilityType   *string                 `json:"visibility_type,omitempty"`
+	HasFieldValues   *string                 `json:"has_field_values,omitempty"`
+	CoercionStrategy *string                 `json:"coercion_strategy"`
+	Settings         *map[string]interface{} `json:"settings"`
+}
+
+// Get fetches the metadata of an existing field.
+func (s *FieldService) Get(ctx context.Context, id int64) (*Field, error) {
+	var resp Field
+	err := s.client.Get(ctx, fmt.Sprintf("/field/%d", id), &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching field %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// Find searches for the field with the given name in a table.
+func (s *FieldService) Find(ctx context.Context, tableId int64, name string) (*Field, error) {
+	table, err := s.client.Table.GetQueryMetadata(ctx, tableId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range table.Fields {
+		if field.Name == name {
+			return &field, nil
+		}
+	}
+
+	return nil, fmt.Errorf("error finding field %s in table %d: %w", name, tableId, ErrNotFound)
+}
+
+// Update updates the metadata of an existing field.
+func (s *FieldService) Update(ctx context.Context, id int64, request *UpdateFieldRequest) error {
+	err := s.client.Put(ctx, fmt.Sprintf("/field/%d", id), request, nil)
+	if err != nil {
+		return fmt.Errorf("error updating field %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// SetDimension sets the remapping of a field's values, replacing any existing remapping.
+func (s *FieldService) SetDimension(ctx context.Context, id int64, dimension *Dimension) error {
+	err := s.client.Post(ctx, fmt.Sprintf("/field/%d/dimension", id), dimension, nil)
+	if err != nil {
+		return fmt.Errorf("error setting dimension for field %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// DeleteDimension removes the remapping of a field's values.
+func (s *FieldService) DeleteDimension(ctx context.Context, id int64) error {
+	err := s.client.Delete(ctx, fmt.Sprintf("/field/%d/dimension", id), nil)
+	if err != nil {
+		return fmt.Errorf("error deleting dimension for field %d: %w", id, err)
+	}
+
+	return nil
+}
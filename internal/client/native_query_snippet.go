@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+type NativeQuerySnippetService struct {
+	client *Client
+}
+
+// NativeQuerySnippet represents a reusable piece of SQL which can be included in native queries using
+// {{snippet: name}}.
+type NativeQuerySnippet struct {
+	Id           int64   `json:"id"`
+	Name         string  `json:"name"`
+	Description  *string `json:"description"`
+	Content      string  `json:"content"`
+	CollectionId *int64  `json:"collection_id"`
+	Archived     bool    `json:"archived"`
+	CreatorId    int64   `json:"creator_id"`
+	CreatedAt    string  `json:"created_at"`
+	UpdatedAt    string  `json:"updated_at"`
+}
+
+// NativeQuerySnippetRequest represents the request body used to create or update a snippet.
+type NativeQuerySnippetRequest struct {
+	Name         string  `json:"name"`
+	Description  *string `json:"description"`
+	Content      string  `json:"content"`
+	CollectionId *int64  `json:"collection_id"`
+	Archived     *bool   `json:"archived,omitempty"`
+}
+
+// Create creates a new snippet.
+func (s *NativeQuerySnippetService) Create(ctx context.Context, request *NativeQuerySnippetRequest) (*NativeQuerySnippet, error) {
+	var resp NativeQuerySnippet
+	err := s.client.Post(ctx, "/native-query-snippet", request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error creating snippet: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// List fetches the details of all snippets which are, or are not, archived.
+func (s *NativeQuerySnippetService) List(ctx context.Context, archived bool) ([]NativeQuerySnippet, error) {
+	var resp []NativeQuerySnippet
+	err := s.client.Get(ctx, fmt.Sprintf("/native-query-snippet?archived=%t", archived), &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error listing snippets: %w", err)
+	}
+
+	return resp, nil
+}
+
+// Get fetches the details of an existing snippet.
+func (s *NativeQuerySnippetService) Get(ctx context.Context, id int64) (*NativeQuerySnippet, error) {
+	var resp NativeQuerySnippet
+	err := s.client.Get(ctx, fmt.Sprintf("/native-query-snippet/%d", id), &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching snippet %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// Update updates an existing snippet.
+func (s *NativeQuerySnippetService) Update(ctx context.Context, id int64, request *NativeQuerySnippetRequest) (*NativeQuerySnippet, error) {
+	var resp NativeQuerySnippet
+	err := s.client.Put(ctx, fmt.Sprintf("/native-query-snippet/%d", id), request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error updating snippet %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// Archive archives an existing snippet. The API does not support deleting snippets, so this is the closest equivalent.
+func (s *NativeQuerySnippetService) Archive(ctx context.Context, id int64) error {
+	err := s.client.Put(ctx, fmt.Sprintf("/native-query-snippet/%d", id), map[string]bool{"archived": true}, nil)
+	if err != nil {
+		return fmt.Errorf("error archiving snippet %d: %w", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"github.com/bnjns/metabase-sdk-go/service/permissions"
+)
+
+type PermissionsService struct {
+	client *Client
+}
+
+// The magic group types Metabase uses to identify its built-in groups, which are returned by Metabase v0.50 and later.
+const (
+	MagicGroupTypeAllUsers       = "all-internal-users"
+	MagicGroupTypeAdministrators = "admin"
+)
+
+// PermissionsGroup extends the SDK's group with the magic group type, which is only set for the built-in groups.
+type PermissionsGroup struct {
+	permissions.Group
+	MagicGroupType *string `json:"magic_group_type"`
+}
+
+// ListGroups fetches the details of all permissions groups, including their member counts. The members themselves are
+// not included.
+func (s *PermissionsService) ListGroups(ctx context.Context) ([]permissions.Group, error) {
+	groups, err := s.ListGroupsWithType(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]permissions.Group, len(groups))
+	for i, group := range groups {
+		result[i] = group.Group
+	}
+
+	return result, nil
+}
+
+// ListGroupsWithType fetches the details of all permissions groups in the same way as ListGroups, but also includes the
+// magic group type used to identify the built-in groups.
+func (s *PermissionsService) ListGroupsWithType(ctx context.Context) ([]PermissionsGroup, error) {
+	var resp []PermissionsGroup
+	err := s.client.Get(ctx, "/permissions/group", &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error listing permissions groups: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	ChannelTypeEmail = "email"
+	ChannelTypeSlack = "slack"
+)
+
+type PulseService struct {
+	client *Client
+}
+
+// Pulse represents a dashboard subscription, which sends the cards of a dashboard to a set of channels on a schedule.
+type Pulse struct {
+	Id           int64            `json:"id"`
+	Name         string           `json:"name"`
+	DashboardId  *int64           `json:"dashboard_id"`
+	CollectionId *int64           `json:"collection_id"`
+	Cards        []PulseCard      `json:"cards"`
+	Channels     []PulseChannel   `json:"channels"`
+	SkipIfEmpty  bool             `json:"skip_if_empty"`
+	Parameters   []map[string]any `json:"parameters"`
+	Archived     bool             `json:"archived"`
+	CreatorId    int64            `json:"creator_id"`
+	CreatedAt    string           `json:"created_at"`
+	UpdatedAt    string           `json:"updated_at"`
+}
+
+// PulseCard represents a card which is included in a pulse. For dashboard subscriptions, the DashboardCardId
+// identifies the card's position on the dashboard.
+type PulseCard struct {
+	Id              int64  `json:"id"`
+	DashboardCardId *int64 `json:"dashboard_card_id,omitempty"`
+	IncludeCsv      bool   `json:"include_csv"`
+	IncludeXls      bool   `json:"include_xls"`
+}
+
+// PulseChannel represents a channel which a pulse or alert is sent to, along with the schedule it is sent on.
+type PulseChannel struct {
+	Id            *int64           `json:"id,omitempty"`
+	ChannelType   string           `json:"channel_type"`
+	Enabled       bool             `json:"enabled"`
+	ScheduleType  string           `json:"schedule_type"`
+	ScheduleHour  *int64           `json:"schedule_hour"`
+	ScheduleDay   *string          `json:"schedule_day"`
+	ScheduleFrame *string          `json:"schedule_frame"`
+	Recipients    []PulseRecipient `json:"recipients"`
+	Details       map[string]any   `json:"details,omitempty"`
+}
+
+// PulseRecipient represents a recipient of an email channel, which is either an existing user (identified by their ID)
+// or an external email address.
+type PulseRecipient struct {
+	Id    *int64 `json:"id,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+// PulseRequest represents the request body used to create or update a pulse.
+type PulseRequest struct {
+	Name        string           `json:"name"`
+	DashboardId *int64           `json:"dashboard_id,omitempty"`
+	Cards       []PulseCard      `json:"cards"`
+	Channels    []PulseChannel   `json:"channels"`
+	SkipIfEmpty bool             `json:"skip_if_empty"`
+	Parameters  []map[string]any `json:"parameters"`
+	Archived    *bool            `json:"archived,omitempty"`
+}
+
+// Create creates a new pulse.
+func (s *PulseService) Create(ctx context.Context, request *PulseRequest) (*Pulse, error) {
+	var resp Pulse
+	err := s.client.Post(ctx, "/pulse", request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error creating pulse: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// Get fetches the details of an existing pulse. Archived pulses are still returned.
+func (s *PulseService) Get(ctx context.Context, id int64) (*Pulse, error) {
+	var resp Pulse
+	err := s.client.Get(ctx, fmt.Sprintf("/pulse/%d", id), &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching pulse %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// Update updates an existing pulse, replacing its cards and channels.
+func (s *PulseService) Update(ctx context.Context, id int64, request *PulseRequest) (*Pulse, error) {
+	var resp Pulse
+	err := s.client.Put(ctx, fmt.Sprintf("/pulse/%d", id), request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error updating pulse %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// Archive archives an existing pulse. The API does not support deleting pulses, so this is the closest equivalent.
+func (s *PulseService) Archive(ctx context.Context, id int64) error {
+	err := s.client.Put(ctx, fmt.Sprintf("/pulse/%d", id), map[string]bool{"archived": true}, nil)
+	if err != nil {
+		return fmt.Errorf("error archiving pulse %d: %w", id, err)
+	}
+
+	return nil
+}
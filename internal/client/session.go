@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/bnjns/metabase-sdk-go/metabase"
+	"net/http"
+)
+
+var errInvalidSessionToken = errors.New("invalid session token provided")
+
+type SessionService struct {
+	client *Client
+}
+
+// LoginRequest represents the request body used to create a new session.
+type LoginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type loginResponse struct {
+	Id string `json:"id"`
+}
+
+// Create logs into Metabase with the given credentials and returns the ID of the new session. This does not use the
+// client's authenticator, so can be used to create sessions for users other than the one the client is configured with.
+func (s *SessionService) Create(ctx context.Context, request *LoginRequest) (string, error) {
+	var resp loginResponse
+	err := s.client.do(ctx, http.MethodPost, "/session", request, &resp, nil)
+	if err != nil {
+		return "", fmt.Errorf("error creating session: %w", err)
+	}
+
+	return resp.Id, nil
+}
+
+// Delete logs out of the session with the given ID, which invalidates it.
+func (s *SessionService) Delete(ctx context.Context, sessionId string) error {
+	err := s.client.do(ctx, http.MethodDelete, "/session", nil, nil, func(request *http.Request) {
+		request.Header.Set(sessionIdHeader, sessionId)
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting session: %w", err)
+	}
+
+	return nil
+}
+
+// sessionTokenAuthenticator authenticates requests using an existing session token. The SDK does not expose the
+// options type needed to implement OnInit, so the (no-op) implementation is borrowed from the API key authenticator.
+type sessionTokenAuthenticator struct {
+	metabase.Authenticator
+	sessionId string
+}
+
+// NewSessionTokenAuthenticator creates an authenticator that uses an existing session, such as one created by the
+// metabase_session ephemeral resource, rather than logging in.
+func NewSessionTokenAuthenticator(sessionId string) (metabase.Authenticator, error) {
+	if sessionId == "" {
+		return nil, errInvalidSessionToken
+	}
+
+	noopAuthenticator, err := metabase.NewApiKeyAuthenticator(sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sessionTokenAuthenticator{
+		Authenticator: noopAuthenticator,
+		sessionId:     sessionId,
+	}, nil
+}
+
+func (s *sessionTokenAuthenticator) OnRequest(request *http.Request) {
+	request.Header.Set(sessionIdHeader, s.sessionId)
+}
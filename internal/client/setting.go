@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+type SettingService struct {
+	client *Client
+}
+
+// Get fetches the value of a setting, unmarshalling it into value. Sensitive settings are returned redacted.
+func (s *SettingService) Get(ctx context.Context, key string, value any) error {
+	err := s.client.Get(ctx, fmt.Sprintf("/setting/%s", key), value)
+	if err != nil {
+		return fmt.Errorf("error fetching setting %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// Set updates the value of a setting. A nil value resets the setting to its default.
+func (s *SettingService) Set(ctx context.Context, key string, value any) error {
+	err := s.client.Put(ctx, fmt.Sprintf("/setting/%s", key), map[string]any{"value": value}, nil)
+	if err != nil {
+		return fmt.Errorf("error updating setting %s: %w", key, err)
+	}
+
+	return nil
+}
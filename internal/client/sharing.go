@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	SettingEmbeddingSecretKey = "embedding-secret-key"
+	SettingEnableEmbedding    = "enable-embedding"
+
+	EmbeddingParamEnabled  = "enabled"
+	EmbeddingParamLocked   = "locked"
+	EmbeddingParamDisabled = "disabled"
+)
+
+// sharingService implements the endpoints shared by cards and dashboards for public links and static embedding.
+type sharingService struct {
+	client *Client
+	path   string
+	noun   string
+}
+
+// Sharing represents how a card or dashboard is shared outside of Metabase. The embedding params control whether each
+// parameter of an embedded card or dashboard can be changed by the viewer (enabled), must be set by the signed token
+// (locked) or is hidden (disabled).
+type Sharing struct {
+	Id              int64             `json:"id"`
+	PublicUuid      *string           `json:"public_uuid"`
+	EnableEmbedding bool              `json:"enable_embedding"`
+	EmbeddingParams map[string]string `json:"embedding_params"`
+	Archived        bool              `json:"archived"`
+}
+
+// EmbeddingRequest represents the request body used to update the static embedding of a card or dashboard.
+type EmbeddingRequest struct {
+	EnableEmbedding bool              `json:"enable_embedding"`
+	EmbeddingParams map[string]string `json:"embedding_params"`
+}
+
+type publicLinkResponse struct {
+	Uuid string `json:"uuid"`
+}
+
+// GetSharing fetches how an existing card or dashboard is shared.
+func (s *sharingService) GetSharing(ctx context.Context, id int64) (*Sharing, error) {
+	var resp Sharing
+	err := s.client.Get(ctx, fmt.Sprintf("%s/%d", s.path, id), &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching %s %d: %w", s.noun, id, err)
+	}
+
+	return &resp, nil
+}
+
+// CreatePublicLink creates a public link for a card or dashboard, returning its UUID. If the card or dashboard already
+// has a public link, the existing UUID is returned. Public sharing must be enabled.
+func (s *sharingService) CreatePublicLink(ctx context.Context, id int64) (string, error) {
+	var resp publicLinkResponse
+	err := s.client.Post(ctx, fmt.Sprintf("%s/%d/public_link", s.path, id), nil, &resp)
+	if err != nil {
+		return "", fmt.Errorf("error creating public link for %s %d: %w", s.noun, id, err)
+	}
+
+	return resp.Uuid, nil
+}
+
+// DeletePublicLink revokes the public link of a card or dashboard.
+func (s *sharingService) DeletePublicLink(ctx context.Context, id int64) error {
+	err := s.client.Delete(ctx, fmt.Sprintf("%s/%d/public_link", s.path, id), nil)
+	if err != nil {
+		return fmt.Errorf("error deleting public link for %s %d: %w", s.noun, id, err)
+	}
+
+	return nil
+}
+
+// UpdateEmbedding updates the static embedding of a card or dashboard. Embedding must be enabled.
+func (s *sharingService) UpdateEmbedding(ctx context.Context, id int64, request *EmbeddingRequest) (*Sharing, error) {
+	var resp Sharing
+	err := s.client.Put(ctx, fmt.Sprintf("%s/%d", s.path, id), request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error updating embedding for %s %d: %w", s.noun, id, err)
+	}
+
+	return &resp, nil
+}
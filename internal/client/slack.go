@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	SettingSlackAppToken         = "slack-app-token"
+	SettingSlackBugReportChannel = "slack-bug-report-channel"
+	SettingSlackTokenValid       = "slack-token-valid?"
+)
+
+type SlackService struct {
+	client *Client
+}
+
+// SlackSettings represents the Slack integration settings. The app token is validated by Metabase before the settings
+// are saved, so is only included when it should be changed.
+type SlackSettings struct {
+	AppToken         *string `json:"slack-app-token,omitempty"`
+	BugReportChannel *string `json:"slack-bug-report-channel"`
+}
+
+// UpdateSettings updates the Slack integration settings.
+func (s *SlackService) UpdateSettings(ctx context.Context, settings *SlackSettings) error {
+	err := s.client.Put(ctx, "/slack/settings", settings, nil)
+	if err != nil {
+		return fmt.Errorf("error updating Slack settings: %w", err)
+	}
+
+	return nil
+}
+
+// Disconnect removes the app token, which disconnects Metabase from Slack.
+func (s *SlackService) Disconnect(ctx context.Context) error {
+	err := s.client.Put(ctx, "/slack/settings", map[string]any{SettingSlackAppToken: nil}, nil)
+	if err != nil {
+		return fmt.Errorf("error disconnecting Slack: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+type TableService struct {
+	client *Client
+}
+
+// Table represents the metadata of a table which has been synced from a database.
+type Table struct {
+	Id               int64   `json:"id"`
+	DbId             int64   `json:"db_id"`
+	Schema           *string `json:"schema"`
+	Name             string  `json:"name"`
+	DisplayName      string  `json:"display_name"`
+	Description      *string `json:"description"`
+	Caveats          *string `json:"caveats"`
+	PointsOfInterest *string `json:"points_of_interest"`
+	VisibilityType   *string `json:"visibility_type"`
+	EntityType       *string `json:"entity_type"`
+	FieldOrder       string  `json:"field_order"`
+	Active           bool    `json:"active"`
+
+	// Fields is only populated when fetching the query metadata
+	Fields []Field `json:"fields"`
+}
+
+// UpdateTableRequest represents the request body used to update the metadata of a table. The fields which can be
+// cleared are always sent, whereas the others are omitted if not set.
+type UpdateTableRequest struct {
+	DisplayName      *string `json:"display_name,omitempty"`
+	Description      *string `json:"description"`
+	Caveats          *string `json:"caveats"`
+	PointsOfInterest *string `json:"points_of_interest"`
+	VisibilityType   *string `json:"visibility_type"`
+	EntityType       *string `json:"entity_type,omitempty"`
+	FieldOrder       *string `json:"field_order,omitempty"`
+}
+
+// List fetches the metadata of all tables in all databases.
+func (s *TableService) List(ctx context.Context) ([]Table, error) {
+	var resp []Table
+	err := s.client.Get(ctx, "/table", &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tables: %w", err)
+	}
+
+	return resp, nil
+}
+
+// Get fetches the metadata of an existing table.
+func (s *TableService) Get(ctx context.Context, id int64) (*Table, error) {
+	var resp Table
+	err := s.client.Get(ctx, fmt.Sprintf("/table/%d", id), &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching table %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// GetQueryMetadata fetches the metadata of an existing table, including its fields.
+func (s *TableService) GetQueryMetadata(ctx context.Context, id int64) (*Table, error) {
+	var resp Table
+	err := s.client.Get(ctx, fmt.Sprintf("/table/%d/query_metadata", id), &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching query metadata for table %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// Find searches for the table with the given name and schema in a database, using the metadata of the database so that
+// only its tables are fetched. The schema can be nil for databases which don't support schemas.
+func (s *TableService) Find(ctx context.Context, databaseId int64, schema *string, name string) (*Table, error) {
+	metadata, err := s.client.Database.GetMetadata(ctx, databaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, table := range metadata.Tables {
+		if table.Name != name {
+			continue
+		}
+		if (schema == nil && table.Schema == nil) || (schema != nil && table.Schema != nil && *schema == *table.Schema) {
+			return &table, nil
+		}
+	}
+
+	return nil, fmt.Errorf("error finding table %s in database %d: %w", name, databaseId, ErrNotFound)
+}
+
+// Update updates the metadata of an existing table.
+func (s *TableService) Update(ctx context.Context, id int64, request *UpdateTableRequest) error {
+	err := s.client.Put(ctx, fmt.Sprintf("/table/%d", id), request, nil)
+	if err != nil {
+		return fmt.Errorf("error updating table %d: %w", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// tableDefinitionService implements the endpoints shared by segments and legacy metrics, which are both named MBQL
+// definitions on a table.
+type tableDefinitionService struct {
+	client *Client
+	path   string
+	noun   string
+}
+
+type SegmentService struct {
+	tableDefinitionService
+}
+
+type LegacyMetricService struct {
+	tableDefinitionService
+}
+
+// TableDefinition represents a segment or legacy metric, whose Definition is the MBQL filter or aggregation it applies
+// to the table.
+type TableDefinition struct {
+	Id          int64          `json:"id"`
+	Name        string         `json:"name"`
+	Description *string        `json:"description"`
+	TableId     int64          `json:"table_id"`
+	Definition  map[string]any `json:"definition"`
+	Archived    bool           `json:"archived"`
+	CreatorId   int64          `json:"creator_id"`
+	CreatedAt   string         `json:"created_at"`
+	UpdatedAt   string         `json:"updated_at"`
+}
+
+// CreateTableDefinitionRequest represents the request body used to create a new segment or legacy metric.
+type CreateTableDefinitionRequest struct {
+	Name        string         `json:"name"`
+	Description *string        `json:"description"`
+	TableId     int64          `json:"table_id"`
+	Definition  map[string]any `json:"definition"`
+}
+
+// UpdateTableDefinitionRequest represents the request body used to update an existing segment or legacy metric. The
+// API requires a message describing the change, which is recorded in the revision history.
+type UpdateTableDefinitionRequest struct {
+	Name            string         `json:"name"`
+	Description     *string        `json:"description"`
+	Definition      map[string]any `json:"definition"`
+	RevisionMessage string         `json:"revision_message"`
+	Archived        *bool          `json:"archived,omitempty"`
+}
+
+// Create creates a new segment or legacy metric.
+func (s *tableDefinitionService) Create(ctx context.Context, request *CreateTableDefinitionRequest) (*TableDefinition, error) {
+	var resp TableDefinition
+	err := s.client.Post(ctx, s.path, request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s: %w", s.noun, err)
+	}
+
+	return &resp, nil
+}
+
+// Get fetches the details of an existing segment or legacy metric. Archived definitions are still returned.
+func (s *tableDefinitionService) Get(ctx context.Context, id int64) (*TableDefinition, error) {
+	var resp TableDefinition
+	err := s.client.Get(ctx, fmt.Sprintf("%s/%d", s.path, id), &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching %s %d: %w", s.noun, id, err)
+	}
+
+	return &resp, nil
+}
+
+// Update updates an existing segment or legacy metric.
+func (s *tableDefinitionService) Update(ctx context.Context, id int64, request *UpdateTableDefinitionRequest) (*TableDefinition, error) {
+	var resp TableDefinition
+	err := s.client.Put(ctx, fmt.Sprintf("%s/%d", s.path, id), request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error updating %s %d: %w", s.noun, id, err)
+	}
+
+	return &resp, nil
+}
+
+// Archive archives an existing segment or legacy metric, recording the message in its revision history.
+func (s *tableDefinitionService) Archive(ctx context.Context, id int64, revisionMessage string) error {
+	request := map[string]any{"archived": true, "revision_message": revisionMessage}
+	err := s.client.Put(ctx, fmt.Sprintf("%s/%d", s.path, id), request, nil)
+	if err != nil {
+		return fmt.Errorf("error archiving %s %d: %w", s.noun, id, err)
+	}
+
+	return nil
+}
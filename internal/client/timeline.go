@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+type TimelineService struct {
+	client *Client
+}
+
+type TimelineEventService struct {
+	client *Client
+}
+
+// Timeline represents a collection of events which are shown on time series charts in the same collection.
+type Timeline struct {
+	Id           int64   `json:"id"`
+	Name         string  `json:"name"`
+	Description  *string `json:"description"`
+	Icon         string  `json:"icon"`
+	CollectionId *int64  `json:"collection_id"`
+	Default      bool    `json:"default"`
+	Archived     bool    `json:"archived"`
+	CreatorId    int64   `json:"creator_id"`
+	CreatedAt    string  `json:"created_at"`
+	UpdatedAt    string  `json:"updated_at"`
+}
+
+// TimelineRequest represents the request body used to create or update a timeline.
+type TimelineRequest struct {
+	Name         string  `json:"name"`
+	Description  *string `json:"description"`
+	Icon         *string `json:"icon,omitempty"`
+	CollectionId *int64  `json:"collection_id"`
+	Default      bool    `json:"default"`
+}
+
+// TimelineEvent represents an event on a timeline, such as a release or an incident.
+type TimelineEvent struct {
+	Id          int64   `json:"id"`
+	TimelineId  int64   `json:"timeline_id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	Timestamp   string  `json:"timestamp"`
+	TimeMatters bool    `json:"time_matters"`
+	Timezone    string  `json:"timezone"`
+	Icon        string  `json:"icon"`
+	Archived    bool    `json:"archived"`
+	CreatorId   int64   `json:"creator_id"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+}
+
+// TimelineEventRequest represents the request body used to create or update a timeline event.
+type TimelineEventRequest struct {
+	TimelineId  int64   `json:"timeline_id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	Timestamp   string  `json:"timestamp"`
+	TimeMatters bool    `json:"time_matters"`
+	Timezone    string  `json:"timezone"`
+	Icon        *string `json:"icon,omitempty"`
+}
+
+// Create creates a new timeline.
+func (s *TimelineService) Create(ctx context.Context, request *TimelineRequest) (*Timeline, error) {
+	var resp Timeline
+	err := s.client.Post(ctx, "/timeline", request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error creating timeline: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// Get fetches the details of an existing timeline. Archived timelines are still returned.
+func (s *TimelineService) Get(ctx context.Context, id int64) (*Timeline, error) {
+	var resp Timeline
+	err := s.client.Get(ctx, fmt.Sprintf("/timeline/%d", id), &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching timeline %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// Update updates an existing timeline.
+func (s *TimelineService) Update(ctx context.Context, id int64, request *TimelineRequest) (*Timeline, error) {
+	var resp Timeline
+	err := s.client.Put(ctx, fmt.Sprintf("/timeline/%d", id), request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error updating timeline %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// Archive archives an existing timeline, along with all of its events.
+func (s *TimelineService) Archive(ctx context.Context, id int64) error {
+	err := s.client.Put(ctx, fmt.Sprintf("/timeline/%d", id), map[string]bool{"archived": true}, nil)
+	if err != nil {
+		return fmt.Errorf("error archiving timeline %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// Create creates a new timeline event.
+func (s *TimelineEventService) Create(ctx context.Context, request *TimelineEventRequest) (*TimelineEvent, error) {
+	var resp TimelineEvent
+	err := s.client.Post(ctx, "/timeline-event", request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error creating timeline event: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// Get fetches the details of an existing timeline event. Archived events are still returned.
+func (s *TimelineEventService) Get(ctx context.Context, id int64) (*TimelineEvent, error) {
+	var resp TimelineEvent
+	err := s.client.Get(ctx, fmt.Sprintf("/timeline-event/%d", id), &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching timeline event %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// Update updates an existing timeline event.
+func (s *TimelineEventService) Update(ctx context.Context, id int64, request *TimelineEventRequest) (*TimelineEvent, error) {
+	var resp TimelineEvent
+	err := s.client.Put(ctx, fmt.Sprintf("/timeline-event/%d", id), request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("error updating timeline event %d: %w", id, err)
+	}
+
+	return &resp, nil
+}
+
+// Archive archives an existing timeline event, which hides it from charts.
+func (s *TimelineEventService) Archive(ctx context.Context, id int64) error {
+	err := s.client.Put(ctx, fmt.Sprintf("/timeline-event/%d", id), map[string]bool{"archived": true}, nil)
+	if err != nil {
+		return fmt.Errorf("error archiving timeline event %d: %w", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"github.com/bnjns/metabase-sdk-go/service/user"
+	"net/url"
+	"strconv"
+)
+
+// userPageSize is the number of users requested per page when listing users.
+const userPageSize = 50
+
+type UserService struct {
+	client *Client
+}
+
+// ListUsersRequest represents the filters supported when listing users. Status is one of active (the default),
+// deactivated or all. Query matches against the users' names and email addresses, so any exact matching must be done
+// by the caller.
+type ListUsersRequest struct {
+	Status  string
+	Query   *string
+	GroupId *int64
+}
+
+type listUsersResponse struct {
+	Data  []listedUser `json:"data"`
+	Total int64        `json:"total"`
+}
+
+// listedUser represents a user in the list response, which depending on the Metabase version and the permissions of
+// the current user may include the user's groups as a list of IDs rather than their memberships.
+type listedUser struct {
+	user.User
+	GroupIds []int64 `json:"group_ids"`
+}
+
+// List fetches the details of all users matching the request, paging through the results as needed.
+func (s *UserService) List(ctx context.Context, request *ListUsersRequest) ([]user.User, error) {
+	params := url.Values{}
+	if request.Status != "" {
+		params.Set("status", request.Status)
+	}
+	if request.Query != nil {
+		params.Set("query", *request.Query)
+	}
+	if request.GroupId != nil {
+		params.Set("group_id", strconv.FormatInt(*request.GroupId, 10))
+	}
+	params.Set("limit", strconv.Itoa(userPageSize))
+
+	users := make([]user.User, 0)
+	for {
+		params.Set("offset", strconv.Itoa(len(users)))
+
+		var resp listUsersResponse
+		err := s.client.Get(ctx, "/user?"+params.Encode(), &resp)
+		if err != nil {
+			return nil, fmt.Errorf("error listing users: %w", err)
+		}
+
+		for _, listed := range resp.Data {
+			if len(listed.GroupMemberships) == 0 {
+				for _, groupId := range listed.GroupIds {
+					listed.GroupMemberships = append(listed.GroupMemberships, user.GroupMembership{Id: groupId})
+				}
+			}
+			users = append(users, listed.User)
+		}
+		if len(resp.Data) == 0 || int64(len(users)) >= resp.Total {
+			break
+		}
+	}
+
+	return users, nil
+}
+
+type setPasswordRequest struct {
+	Password string `json:"password"`
+}
+
+// SetPassword sets the password of an existing user. This can only be used by superusers or the user themselves, and
+// has no effect on users who log in via SSO.
+func (s *UserService) SetPassword(ctx context.Context, id int64, password string) error {
+	err := s.client.Put(ctx, fmt.Sprintf("/user/%d/password", id), &setPasswordRequest{Password: password}, nil)
+	if err != nil {
+		return fmt.Errorf("error setting password for user %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// SendInvite sends the invitation email to an existing user again. This requires email to be configured in Metabase.
+func (s *UserService) SendInvite(ctx context.Context, id int64) error {
+	err := s.client.Post(ctx, fmt.Sprintf("/user/%d/send_invite", id), nil, nil)
+	if err != nil {
+		return fmt.Errorf("error sending invite to user %d: %w", id, err)
+	}
+
+	return nil
+}
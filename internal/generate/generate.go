@@ -0,0 +1,128 @@
+package generate
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/bnjns/metabase-sdk-go/service/permissions"
+	"github.com/bnjns/metabase-sdk-go/service/user"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/provider"
+	"terraform-provider-metabase/internal/utils"
+)
+
+// unsupportedObjects are the objects which exist in Metabase but can't be generated yet, as the provider doesn't have
+// resources to manage them. These should be generated once the resources are added.
+var unsupportedObjects = []string{"collections", "cards", "dashboards", "permissions graphs"}
+
+// instance represents the objects fetched from the Metabase instance which configuration is generated for.
+type instance struct {
+	groups    []permissions.Group
+	users     []user.User
+	databases []client.Database
+}
+
+// Run is the entrypoint of the generate subcommand, which connects to the Metabase instance configured with the
+// METABASE_* environment variables and writes the Terraform configuration and import blocks for the objects in it.
+func Run(ctx context.Context, args []string, stderr io.Writer) error {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	outputDir := flags.String("out", ".", "the directory to write the generated configuration to")
+	force := flags.Bool("force", false, "overwrite any existing files in the output directory")
+	if err := flags.Parse(args); errors.Is(err, flag.ErrHelp) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	api, err := createClient()
+	if err != nil {
+		return err
+	}
+
+	inst, err := fetchInstance(ctx, api)
+	if err != nil {
+		return err
+	}
+
+	files, err := render(inst)
+	if err != nil {
+		return err
+	}
+
+	if err := writeFiles(*outputDir, files, *force); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stderr, "Generated configuration for %d groups, %d users and %d databases in %s\n", len(inst.groups), len(inst.users), len(inst.databases), *outputDir)
+	fmt.Fprintf(stderr, "Skipped %s, as the provider does not support managing them yet\n", strings.Join(unsupportedObjects, ", "))
+	return nil
+}
+
+func createClient() (*client.Client, error) {
+	var config provider.MetabaseProviderModel
+
+	host := utils.GetConfigValue(config.Host, "METABASE_HOST")
+	if host == "" {
+		return nil, errors.New("the METABASE_HOST environment variable must be set")
+	}
+
+	auth, err := provider.CreateAuth(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.New(host, auth, map[string]string{}), nil
+}
+
+// fetchInstance fetches the objects which configuration can be generated for, ignoring any which can't be managed.
+func fetchInstance(ctx context.Context, api *client.Client) (*instance, error) {
+	groups, err := api.Permissions.ListGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := api.User.List(ctx, &client.ListUsersRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	databases, err := api.Database.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &instance{
+		groups:    groups,
+		users:     users,
+		databases: databases,
+	}, nil
+}
+
+// writeFiles writes the generated files to the output directory, refusing to overwrite existing files unless forced.
+func writeFiles(outputDir string, files map[string][]byte, force bool) error {
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		return fmt.Errorf("error creating output directory: %w", err)
+	}
+
+	if !force {
+		for name := range files {
+			if _, err := os.Stat(filepath.Join(outputDir, name)); err == nil {
+				return fmt.Errorf("%s already exists, use -force to overwrite it", filepath.Join(outputDir, name))
+			}
+		}
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(outputDir, name), content, 0o644); err != nil {
+			return fmt.Errorf("error writing %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,341 @@
+package generate
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/bnjns/metabase-sdk-go/service/permissions"
+	"github.com/bnjns/metabase-sdk-go/service/user"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+	"golang.org/x/exp/slices"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/provider"
+)
+
+const (
+	groupResourceType    = "metabase_permissions_group"
+	userResourceType     = "metabase_user"
+	databaseResourceType = "metabase_database"
+
+	generatedHeader = "# This file was generated by terraform-provider-metabase generate.\n\n"
+)
+
+// generatedResource records a resource which has been generated, so that an import block can be created for it.
+type generatedResource struct {
+	resourceType string
+	name         string
+	id           int64
+}
+
+func (r generatedResource) traversal(attributes ...string) hcl.Traversal {
+	traversal := hcl.Traversal{
+		hcl.TraverseRoot{Name: r.resourceType},
+		hcl.TraverseAttr{Name: r.name},
+	}
+	for _, attribute := range attributes {
+		traversal = append(traversal, hcl.TraverseAttr{Name: attribute})
+	}
+	return traversal
+}
+
+type renderer struct {
+	usedNames map[string]bool
+	groups    map[int64]generatedResource
+	generated []generatedResource
+	variables *hclwrite.File
+}
+
+// render generates the Terraform configuration for the objects in the instance, returning the contents of each file
+// keyed by its name.
+func render(inst *instance) (map[string][]byte, error) {
+	r := &renderer{
+		usedNames: make(map[string]bool),
+		groups:    make(map[int64]generatedResource),
+		variables: hclwrite.NewEmptyFile(),
+	}
+
+	databases, err := r.renderDatabases(inst.databases)
+	if err != nil {
+		return nil, err
+	}
+
+	// Groups must be rendered before users, so that the users' memberships can reference them
+	files := map[string][]byte{
+		"permissions_groups.tf": r.renderGroups(inst.groups),
+		"users.tf":              r.renderUsers(inst.users),
+		"databases.tf":          databases,
+		"imports.tf":            r.renderImports(),
+	}
+	if len(r.variables.Body().Blocks()) > 0 {
+		files["variables.tf"] = formatFile(r.variables)
+	}
+
+	return files, nil
+}
+
+func (r *renderer) renderGroups(groups []permissions.Group) []byte {
+	groups = slices.Clone(groups)
+	slices.SortFunc(groups, func(a, b permissions.Group) bool {
+		return a.Id < b.Id
+	})
+
+	file := hclwrite.NewEmptyFile()
+	for _, group := range groups {
+		// The built-in groups always exist and cannot be managed
+		if isReservedGroup(group.Id) {
+			continue
+		}
+
+		resource := r.addResource(groupResourceType, group.Name, group.Id)
+		r.groups[group.Id] = resource
+
+		body := appendResourceBlock(file, resource)
+		body.SetAttributeValue("name", cty.StringVal(group.Name))
+	}
+
+	return formatFile(file)
+}
+
+func (r *renderer) renderUsers(users []user.User) []byte {
+	users = slices.Clone(users)
+	slices.SortFunc(users, func(a, b user.User) bool {
+		return a.Id < b.Id
+	})
+
+	file := hclwrite.NewEmptyFile()
+	for _, usr := range users {
+		if !usr.IsActive {
+			continue
+		}
+
+		localPart, _, _ := strings.Cut(usr.Email, "@")
+		resource := r.addResource(userResourceType, localPart, usr.Id)
+
+		body := appendResourceBlock(file, resource)
+		body.SetAttributeValue("email", cty.StringVal(usr.Email))
+		if usr.FirstName != nil {
+			body.SetAttributeValue("first_name", cty.StringVal(*usr.FirstName))
+		}
+		if usr.LastName != nil {
+			body.SetAttributeValue("last_name", cty.StringVal(*usr.LastName))
+		}
+		if usr.Locale != nil {
+			body.SetAttributeValue("locale", cty.StringVal(*usr.Locale))
+		}
+		if usr.IsSuperuser {
+			body.SetAttributeValue("is_superuser", cty.True)
+		}
+		if usr.LoginAttributes != nil && len(*usr.LoginAttributes) > 0 {
+			attributes := make(map[string]cty.Value, len(*usr.LoginAttributes))
+			for key, value := range *usr.LoginAttributes {
+				attributes[key] = cty.StringVal(fmt.Sprint(value))
+			}
+			body.SetAttributeValue("login_attributes", cty.MapVal(attributes))
+		}
+		if memberships := r.buildGroupMemberships(usr.GroupMemberships); memberships != nil {
+			body.SetAttributeRaw("group_memberships", memberships)
+		}
+	}
+
+	return formatFile(file)
+}
+
+// buildGroupMemberships converts the user's group memberships into a list of objects which reference the generated
+// groups, or nil if the user is only a member of the built-in groups.
+func (r *renderer) buildGroupMemberships(memberships []user.GroupMembership) hclwrite.Tokens {
+	memberships = slices.Clone(memberships)
+	slices.SortFunc(memberships, func(a, b user.GroupMembership) bool {
+		return a.Id < b.Id
+	})
+
+	elements := make([]hclwrite.Tokens, 0, len(memberships))
+	for _, membership := range memberships {
+		if isReservedGroup(membership.Id) {
+			continue
+		}
+
+		groupId := hclwrite.TokensForValue(cty.NumberIntVal(membership.Id))
+		if group, ok := r.groups[membership.Id]; ok {
+			groupId = hclwrite.TokensForTraversal(group.traversal("id"))
+		}
+
+		attributes := []hclwrite.ObjectAttrTokens{
+			{Name: hclwrite.TokensForIdentifier("group_id"), Value: groupId},
+		}
+		if membership.IsGroupManager {
+			attributes = append(attributes, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForIdentifier("is_group_manager"),
+				Value: hclwrite.TokensForValue(cty.True),
+			})
+		}
+		elements = append(elements, hclwrite.TokensForObject(attributes))
+	}
+
+	if len(elements) == 0 {
+		return nil
+	}
+	return hclwrite.TokensForTuple(elements)
+}
+
+func (r *renderer) renderDatabases(databases []client.Database) ([]byte, error) {
+	databases = slices.Clone(databases)
+	slices.SortFunc(databases, func(a, b client.Database) bool {
+		return a.Id < b.Id
+	})
+
+	file := hclwrite.NewEmptyFile()
+	for _, db := range databases {
+		// Neither the sample nor the audit databases can be created, so they can't be managed
+		if db.IsSample || db.IsAudit {
+			continue
+		}
+
+		resource := r.addResource(databaseResourceType, db.Name, db.Id)
+
+		details := make(map[string]any)
+		secureKeys := make([]string, 0)
+		if db.Details != nil {
+			for key, value := range *db.Details {
+				if provider.IsSensitiveDatabaseDetail(key, value) {
+					secureKeys = append(secureKeys, key)
+				} else {
+					details[key] = value
+				}
+			}
+		}
+
+		detailsTokens, err := jsonencodeTokens(details)
+		if err != nil {
+			return nil, fmt.Errorf("error generating details for database %d: %w", db.Id, err)
+		}
+
+		body := appendResourceBlock(file, resource)
+		body.SetAttributeValue("engine", cty.StringVal(string(db.Engine)))
+		body.SetAttributeValue("name", cty.StringVal(db.Name))
+		body.SetAttributeRaw("details", detailsTokens)
+		if len(secureKeys) > 0 {
+			body.SetAttributeRaw("details_secure", r.buildSecureDetails(resource, db.Name, secureKeys))
+		}
+	}
+
+	return formatFile(file), nil
+}
+
+// buildSecureDetails creates a sensitive variable for each of the database's secret details, as their values are
+// redacted by Metabase, and returns the details_secure expression which references them.
+func (r *renderer) buildSecureDetails(resource generatedResource, databaseName string, keys []string) hclwrite.Tokens {
+	sort.Strings(keys)
+
+	attributes := make([]hclwrite.ObjectAttrTokens, len(keys))
+	for i, key := range keys {
+		variableName := toIdentifier(resource.name + "_" + key)
+		variable := r.variables.Body().AppendNewBlock("variable", []string{variableName}).Body()
+		variable.SetAttributeValue("description", cty.StringVal(fmt.Sprintf("The %s of the %s database.", key, databaseName)))
+		variable.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+		variable.SetAttributeValue("sensitive", cty.True)
+		r.variables.Body().AppendNewline()
+
+		name := hclwrite.TokensForValue(cty.StringVal(key))
+		if hclsyntax.ValidIdentifier(key) {
+			name = hclwrite.TokensForIdentifier(key)
+		}
+		attributes[i] = hclwrite.ObjectAttrTokens{
+			Name: name,
+			Value: hclwrite.TokensForTraversal(hcl.Traversal{
+				hcl.TraverseRoot{Name: "var"},
+				hcl.TraverseAttr{Name: variableName},
+			}),
+		}
+	}
+
+	return hclwrite.TokensForFunctionCall("jsonencode", hclwrite.TokensForObject(attributes))
+}
+
+func (r *renderer) renderImports() []byte {
+	file := hclwrite.NewEmptyFile()
+	for _, resource := range r.generated {
+		body := file.Body().AppendNewBlock("import", nil).Body()
+		body.SetAttributeTraversal("to", resource.traversal())
+		body.SetAttributeValue("id", cty.StringVal(strconv.FormatInt(resource.id, 10)))
+		file.Body().AppendNewline()
+	}
+
+	return formatFile(file)
+}
+
+// addResource records a new resource, giving it a name which is unique amongst the resources of the same type.
+func (r *renderer) addResource(resourceType string, name string, id int64) generatedResource {
+	base := toIdentifier(name)
+	name = base
+	for i := 2; r.usedNames[resourceType+"."+name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	r.usedNames[resourceType+"."+name] = true
+
+	resource := generatedResource{resourceType: resourceType, name: name, id: id}
+	r.generated = append(r.generated, resource)
+	return resource
+}
+
+func appendResourceBlock(file *hclwrite.File, resource generatedResource) *hclwrite.Body {
+	body := file.Body().AppendNewBlock("resource", []string{resource.resourceType, resource.name}).Body()
+	file.Body().AppendNewline()
+	return body
+}
+
+// jsonencodeTokens converts a JSON-compatible value into a jsonencode() expression, so that it is written as HCL.
+func jsonencodeTokens(value any) (hclwrite.Tokens, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	ctyType, err := ctyjson.ImpliedType(encoded)
+	if err != nil {
+		return nil, err
+	}
+	ctyValue, err := ctyjson.Unmarshal(encoded, ctyType)
+	if err != nil {
+		return nil, err
+	}
+
+	return hclwrite.TokensForFunctionCall("jsonencode", hclwrite.TokensForValue(ctyValue)), nil
+}
+
+// toIdentifier converts a name into a valid Terraform identifier, replacing any unsupported characters with
+// underscores.
+func toIdentifier(name string) string {
+	var builder strings.Builder
+	lastUnderscore := false
+	for _, char := range strings.ToLower(name) {
+		if (char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') || char == '-' {
+			builder.WriteRune(char)
+			lastUnderscore = false
+		} else if !lastUnderscore {
+			builder.WriteRune('_')
+			lastUnderscore = true
+		}
+	}
+
+	identifier := strings.Trim(builder.String(), "_-")
+	if identifier == "" {
+		return "unnamed"
+	} else if identifier[0] >= '0' && identifier[0] <= '9' {
+		return "_" + identifier
+	}
+	return identifier
+}
+
+func isReservedGroup(groupId int64) bool {
+	return groupId == permissions.GroupAllUsers || groupId == permissions.GroupAdministrators
+}
+
+func formatFile(file *hclwrite.File) []byte {
+	return append([]byte(generatedHeader), hclwrite.Format(file.Bytes())...)
+}
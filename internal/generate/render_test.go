@@ -0,0 +1,136 @@
+package generate
+
+import (
+	"github.com/bnjns/metabase-sdk-go/service/database"
+	"github.com/bnjns/metabase-sdk-go/service/permissions"
+	"github.com/bnjns/metabase-sdk-go/service/user"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-metabase/internal/client"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	firstName := "Jane"
+	locale := "en_GB"
+	inst := &instance{
+		groups: []permissions.Group{
+			{Id: permissions.GroupAllUsers, Name: "All Users"},
+			{Id: permissions.GroupAdministrators, Name: "Administrators"},
+			{Id: 3, Name: "Data Analysts"},
+		},
+		users: []user.User{
+			{
+				Id:          5,
+				Email:       "jane.doe@example.com",
+				FirstName:   &firstName,
+				Locale:      &locale,
+				IsActive:    true,
+				IsSuperuser: true,
+				GroupMemberships: []user.GroupMembership{
+					{Id: permissions.GroupAllUsers},
+					{Id: permissions.GroupAdministrators},
+					{Id: 3, IsGroupManager: true},
+				},
+			},
+			{Id: 6, Email: "old@example.com", IsActive: false},
+		},
+		databases: []client.Database{
+			{Database: database.Database{Id: 1, Name: "Sample Database", Engine: "h2", IsSample: true}},
+			{Database: database.Database{
+				Id:     2,
+				Name:   "Warehouse",
+				Engine: database.EnginePostgres,
+				Details: &database.Details{
+					"host":     "postgres",
+					"port":     5432,
+					"password": "**MetabasePass**",
+				},
+			}},
+		},
+	}
+
+	files, err := render(inst)
+	assert.NoError(t, err)
+
+	t.Run("all files should be valid HCL", func(t *testing.T) {
+		for name, content := range files {
+			_, diags := hclsyntax.ParseConfig(content, name, hcl.InitialPos)
+			assert.False(t, diags.HasErrors(), "%s: %s", name, diags.Error())
+		}
+	})
+
+	t.Run("built-in groups should be excluded", func(t *testing.T) {
+		groups := string(files["permissions_groups.tf"])
+
+		assert.Contains(t, groups, `resource "metabase_permissions_group" "data_analysts" {`)
+		assert.NotContains(t, groups, "All Users")
+		assert.NotContains(t, groups, "Administrators")
+	})
+
+	t.Run("users should reference their groups", func(t *testing.T) {
+		users := string(files["users.tf"])
+
+		assert.Contains(t, users, `resource "metabase_user" "jane_doe" {`)
+		assert.Contains(t, users, "is_superuser = true")
+		assert.Contains(t, users, `locale       = "en_GB"`)
+		assert.Contains(t, users, "group_id         = metabase_permissions_group.data_analysts.id")
+		assert.Contains(t, users, "is_group_manager = true")
+		assert.NotContains(t, users, "old@example.com")
+	})
+
+	t.Run("database secrets should be stubbed with variables", func(t *testing.T) {
+		databases := string(files["databases.tf"])
+		variables := string(files["variables.tf"])
+
+		assert.Contains(t, databases, `resource "metabase_database" "warehouse" {`)
+		assert.Contains(t, databases, `host = "postgres"`)
+		assert.Contains(t, databases, "password = var.warehouse_password")
+		assert.NotContains(t, databases, "MetabasePass")
+		assert.NotContains(t, databases, "Sample Database")
+		assert.Contains(t, variables, `variable "warehouse_password" {`)
+		assert.Contains(t, variables, "sensitive   = true")
+	})
+
+	t.Run("import blocks should be generated for each resource", func(t *testing.T) {
+		imports := string(files["imports.tf"])
+
+		assert.Contains(t, imports, "to = metabase_permissions_group.data_analysts\n  id = \"3\"")
+		assert.Contains(t, imports, "to = metabase_user.jane_doe\n  id = \"5\"")
+		assert.Contains(t, imports, "to = metabase_database.warehouse\n  id = \"2\"")
+	})
+}
+
+func TestToIdentifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unsupported characters should be replaced", func(t *testing.T) {
+		assert.Equal(t, "sales_marketing", toIdentifier("Sales & Marketing"))
+	})
+
+	t.Run("identifiers starting with a digit should be prefixed", func(t *testing.T) {
+		assert.Equal(t, "_2024_reports", toIdentifier("2024 Reports"))
+	})
+
+	t.Run("empty names should be given a placeholder", func(t *testing.T) {
+		assert.Equal(t, "unnamed", toIdentifier("!!!"))
+	})
+}
+
+func TestAddResource(t *testing.T) {
+	t.Parallel()
+
+	r := &renderer{usedNames: make(map[string]bool)}
+
+	first := r.addResource(groupResourceType, "Analysts", 3)
+	second := r.addResource(groupResourceType, "analysts", 4)
+	other := r.addResource(userResourceType, "analysts", 5)
+
+	assert.Equal(t, "analysts", first.name)
+	assert.Equal(t, "analysts_2", second.name)
+	assert.Equal(t, "analysts", other.name)
+	assert.Len(t, r.generated, 3)
+}
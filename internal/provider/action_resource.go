@@ -0,0 +1,362 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/transforms"
+	"terraform-provider-metabase/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ActionResource{}
+var _ resource.ResourceWithIdentity = &ActionResource{}
+var _ resource.ResourceWithImportState = &ActionResource{}
+var _ resource.ResourceWithValidateConfig = &ActionResource{}
+
+type ActionResource struct {
+	provider *MetabaseProvider
+}
+
+type ActionModel struct {
+	Id                    types.Int64  `tfsdk:"id"`
+	ModelId               types.Int64  `tfsdk:"model_id"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	Type                  types.String `tfsdk:"type"`
+	Kind                  types.String `tfsdk:"kind"`
+	DatasetQuery          types.String `tfsdk:"dataset_query"`
+	Template              types.String `tfsdk:"template"`
+	Parameters            types.String `tfsdk:"parameters"`
+	VisualizationSettings types.String `tfsdk:"visualization_settings"`
+	Archived              types.Bool   `tfsdk:"archived"`
+	CreatorId             types.Int64  `tfsdk:"creator_id"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+}
+
+func (a *ActionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_action"
+}
+
+func (a *ActionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.ActionResource()
+}
+
+func (a *ActionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schema.ResourceIdentity("action")
+}
+
+func (a *ActionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() || config.Type.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateActionConfig(config)...)
+}
+
+func (a *ActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ActionModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := buildActionRequest(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Actions can only be archived once they've been created
+	request.Archived = nil
+	action, err := a.provider.api.Action.Create(ctx, request)
+	if err == nil && plan.Archived.ValueBool() {
+		request.Archived = plan.Archived.ValueBoolPointer()
+		action, err = a.provider.api.Action.Update(ctx, action.Id, request)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating action",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	diags = mapActionToState(action, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(a.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (a *ActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ActionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actionId := state.Id.ValueInt64()
+	action, err := a.provider.api.Action.Get(ctx, actionId)
+	if err != nil {
+		diags = utils.HandleResourceReadError(ctx, "action", actionId, err, resp)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	diags = mapActionToState(action, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(a.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func (a *ActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ActionModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := buildActionRequest(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actionId := plan.Id.ValueInt64()
+	action, err := a.provider.api.Action.Update(ctx, actionId, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error updating action with ID %d", actionId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	diags = mapActionToState(action, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(a.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (a *ActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ActionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actionId := state.Id.ValueInt64()
+	err := a.provider.api.Action.Delete(ctx, actionId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error deleting action with ID %d", actionId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (a *ActionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	actionId, diags := a.provider.resolveImportId(ctx, req, "the numeric ID of the action")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	action, err := a.provider.api.Action.Get(ctx, actionId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing action with ID %d", actionId),
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	state := ActionModel{
+		DatasetQuery:          types.StringNull(),
+		Template:              types.StringNull(),
+		Parameters:            types.StringNull(),
+		VisualizationSettings: types.StringNull(),
+	}
+	diags = mapActionToState(action, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(a.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+// validateActionConfig checks that only the attributes relevant to the type of action have been provided.
+func validateActionConfig(config ActionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	attributes := map[string]types.String{
+		"kind":          config.Kind,
+		"dataset_query": config.DatasetQuery,
+		"template":      config.Template,
+	}
+	required := map[string]string{
+		client.ActionTypeImplicit: "kind",
+		client.ActionTypeQuery:    "dataset_query",
+		client.ActionTypeHttp:     "template",
+	}
+
+	actionType := config.Type.ValueString()
+	for _, name := range []string{"kind", "dataset_query", "template"} {
+		value := attributes[name]
+		if value.IsUnknown() {
+			continue
+		}
+
+		if required[actionType] == name && value.IsNull() {
+			diags.AddAttributeError(
+				path.Root(name),
+				fmt.Sprintf("Missing %s", name),
+				fmt.Sprintf("You must provide the %s when creating %s action.", name, actionTypeArticle(actionType)),
+			)
+		} else if required[actionType] != name && !value.IsNull() {
+			diags.AddAttributeError(
+				path.Root(name),
+				fmt.Sprintf("Invalid %s", name),
+				fmt.Sprintf("The %s cannot be provided when creating %s action.", name, actionTypeArticle(actionType)),
+			)
+		}
+	}
+
+	return diags
+}
+
+func actionTypeArticle(actionType string) string {
+	if actionType == client.ActionTypeImplicit {
+		return "an implicit"
+	}
+
+	return fmt.Sprintf("a %s", actionType)
+}
+
+func buildActionRequest(plan ActionModel) (*client.ActionRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var datasetQuery, template, visualizationSettings map[string]any
+	var parameters []map[string]any
+	unmarshalActionJson(plan.DatasetQuery, "dataset_query", "a JSON object", &datasetQuery, &diags)
+	unmarshalActionJson(plan.Template, "template", "a JSON object", &template, &diags)
+	unmarshalActionJson(plan.Parameters, "parameters", "a JSON list of objects", &parameters, &diags)
+	unmarshalActionJson(plan.VisualizationSettings, "visualization_settings", "a JSON object", &visualizationSettings, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Query actions need the ID of the database the query is run against, which is taken from the query itself
+	var databaseId *int64
+	if database, ok := datasetQuery["database"].(float64); ok {
+		id := int64(database)
+		databaseId = &id
+	}
+
+	return &client.ActionRequest{
+		Name:                  plan.Name.ValueString(),
+		Description:           plan.Description.ValueStringPointer(),
+		Type:                  plan.Type.ValueString(),
+		ModelId:               plan.ModelId.ValueInt64(),
+		Kind:                  plan.Kind.ValueStringPointer(),
+		DatabaseId:            databaseId,
+		DatasetQuery:          datasetQuery,
+		Template:              template,
+		Parameters:            parameters,
+		VisualizationSettings: visualizationSettings,
+		Archived:              plan.Archived.ValueBoolPointer(),
+	}, diags
+}
+
+func unmarshalActionJson(value types.String, name string, expected string, target any, diags *diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	if err := json.Unmarshal([]byte(value.ValueString()), target); err != nil {
+		diags.AddAttributeError(
+			path.Root(name),
+			fmt.Sprintf("Invalid %s", name),
+			fmt.Sprintf("The %s must be %s: %s", name, expected, err.Error()),
+		)
+	}
+}
+
+func mapActionToState(action *client.Action, target *ActionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	target.Id = types.Int64Value(action.Id)
+	target.ModelId = types.Int64Value(action.ModelId)
+	target.Name = types.StringValue(action.Name)
+	target.Description = transforms.ToTerraformString(action.Description)
+	target.Type = types.StringValue(action.Type)
+	target.Kind = transforms.ToTerraformString(action.Kind)
+	target.Archived = types.BoolValue(action.Archived)
+	target.CreatorId = types.Int64Value(action.CreatorId)
+	target.CreatedAt = types.StringValue(action.CreatedAt)
+
+	diags.Append(mapActionJsonToState(&target.DatasetQuery, "dataset_query", action.DatasetQuery, len(action.DatasetQuery) == 0)...)
+	diags.Append(mapActionJsonToState(&target.Template, "template", action.Template, len(action.Template) == 0)...)
+	diags.Append(mapActionJsonToState(&target.VisualizationSettings, "visualization_settings", action.VisualizationSettings, len(action.VisualizationSettings) == 0)...)
+
+	// Metabase generates the parameters of implicit actions if they weren't provided, so these are only tracked if they
+	// have been configured
+	if action.Type != client.ActionTypeImplicit || !target.Parameters.IsNull() {
+		diags.Append(mapActionJsonToState(&target.Parameters, "parameters", action.Parameters, len(action.Parameters) == 0)...)
+	}
+
+	return diags
+}
+
+// mapActionJsonToState only updates the JSON attribute when its value has changed, so formatting differences don't cause
+// a diff. Empty values are stored as null, unless they were configured as empty.
+func mapActionJsonToState(target *types.String, name string, value any, empty bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if empty {
+		if target.IsUnknown() || !(utils.JsonEquivalent(target.ValueString(), "{}") || utils.JsonEquivalent(target.ValueString(), "[]")) {
+			*target = types.StringNull()
+		}
+		return diags
+	}
+
+	valueJson, err := json.Marshal(value)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error processing %s", name), fmt.Sprintf("An error occurred: %s", err.Error()))
+	} else if target.IsNull() || target.IsUnknown() || !utils.JsonEquivalent(target.ValueString(), string(valueJson)) {
+		*target = types.StringValue(string(valueJson))
+	}
+
+	return diags
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"terraform-provider-metabase/internal/client"
+	"testing"
+)
+
+func TestValidateActionConfig(t *testing.T) {
+	t.Parallel()
+
+	buildConfig := func(actionType string) ActionModel {
+		return ActionModel{
+			Type:         types.StringValue(actionType),
+			Kind:         types.StringNull(),
+			DatasetQuery: types.StringNull(),
+			Template:     types.StringNull(),
+		}
+	}
+
+	t.Run("an implicit action with a kind should be valid", func(t *testing.T) {
+		config := buildConfig(client.ActionTypeImplicit)
+		config.Kind = types.StringValue("row/create")
+
+		assert.Empty(t, validateActionConfig(config))
+	})
+
+	t.Run("an implicit action without a kind should be invalid", func(t *testing.T) {
+		diags := validateActionConfig(buildConfig(client.ActionTypeImplicit))
+
+		assert.Len(t, diags, 1)
+		assert.Equal(t, "Missing kind", diags[0].Summary())
+	})
+
+	t.Run("a query action with a kind should be invalid", func(t *testing.T) {
+		config := buildConfig(client.ActionTypeQuery)
+		config.Kind = types.StringValue("row/create")
+		config.DatasetQuery = types.StringValue(`{"database":1}`)
+
+		diags := validateActionConfig(config)
+
+		assert.Len(t, diags, 1)
+		assert.Equal(t, "Invalid kind", diags[0].Summary())
+	})
+
+	t.Run("unknown values should not be validated", func(t *testing.T) {
+		config := buildConfig(client.ActionTypeHttp)
+		config.Template = types.StringUnknown()
+
+		assert.Empty(t, validateActionConfig(config))
+	})
+}
+
+func TestBuildActionRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("the database should be taken from the query", func(t *testing.T) {
+		request, diags := buildActionRequest(ActionModel{
+			Type:         types.StringValue(client.ActionTypeQuery),
+			DatasetQuery: types.StringValue(`{"database":2,"type":"native","native":{"query":"DELETE FROM orders"}}`),
+		})
+
+		assert.Empty(t, diags)
+		assert.Equal(t, int64(2), *request.DatabaseId)
+	})
+
+	t.Run("invalid parameters should return an error", func(t *testing.T) {
+		_, diags := buildActionRequest(ActionModel{
+			Type:       types.StringValue(client.ActionTypeImplicit),
+			Parameters: types.StringValue(`{"id":"name"}`),
+		})
+
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Invalid parameters", diags[0].Summary())
+	})
+}
+
+func TestMapActionToState(t *testing.T) {
+	t.Parallel()
+
+	kind := "row/create"
+	implicitAction := &client.Action{
+		Id:         1,
+		Type:       client.ActionTypeImplicit,
+		Kind:       &kind,
+		Parameters: []map[string]any{{"id": "name", "type": "string/="}},
+	}
+
+	t.Run("generated parameters of implicit actions should not be tracked", func(t *testing.T) {
+		state := ActionModel{Parameters: types.StringNull()}
+
+		diags := mapActionToState(implicitAction, &state)
+
+		assert.Empty(t, diags)
+		assert.True(t, state.Parameters.IsNull())
+	})
+
+	t.Run("configured parameters should be kept if equivalent", func(t *testing.T) {
+		state := ActionModel{Parameters: types.StringValue(`[{"type": "string/=", "id": "name"}]`)}
+
+		diags := mapActionToState(implicitAction, &state)
+
+		assert.Empty(t, diags)
+		assert.Equal(t, `[{"type": "string/=", "id": "name"}]`, state.Parameters.ValueString())
+	})
+
+	t.Run("empty visualization settings should be null", func(t *testing.T) {
+		state := ActionModel{VisualizationSettings: types.StringValue(`{"name":"Create"}`)}
+
+		diags := mapActionToState(&client.Action{Id: 1, Type: client.ActionTypeQuery, VisualizationSettings: map[string]any{}}, &state)
+
+		assert.Empty(t, diags)
+		assert.True(t, state.VisualizationSettings.IsNull())
+	})
+}
+
+func TestAccActionResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "metabase_action" "test" {
+	model_id = 1
+	name     = "Create order"
+	type     = "implicit"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing kind"),
+			},
+			{
+				Config: providerConfig + `
+resource "metabase_action" "test" {
+	model_id      = 1
+	name          = "Cancel order"
+	type          = "implicit"
+	kind          = "row/update"
+	dataset_query = jsonencode({ database = 1 })
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid dataset_query"),
+			},
+		},
+	})
+}
@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/utils"
+)
+
+// goalAlertDisplays are the card displays which support goal alerts, as they are the only ones with a goal line.
+var goalAlertDisplays = []string{"line", "area", "bar", "progress"}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AlertResource{}
+var _ resource.ResourceWithIdentity = &AlertResource{}
+var _ resource.ResourceWithImportState = &AlertResource{}
+var _ resource.ResourceWithModifyPlan = &AlertResource{}
+var _ resource.ResourceWithValidateConfig = &AlertResource{}
+
+type AlertResource struct {
+	provider *MetabaseProvider
+}
+
+type AlertModel struct {
+	Id             types.Int64  `tfsdk:"id"`
+	CardId         types.Int64  `tfsdk:"card_id"`
+	AlertCondition types.String `tfsdk:"alert_condition"`
+	AlertFirstOnly types.Bool   `tfsdk:"alert_first_only"`
+	AlertAboveGoal types.Bool   `tfsdk:"alert_above_goal"`
+	Email          types.Object `tfsdk:"email"`
+	Slack          types.Object `tfsdk:"slack"`
+	Schedule       types.Object `tfsdk:"schedule"`
+	CreatorId      types.Int64  `tfsdk:"creator_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+func (a *AlertResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert"
+}
+
+func (a *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.AlertResource()
+}
+
+func (a *AlertResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schema.ResourceIdentity("alert")
+}
+
+func (a *AlertResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateNotificationChannels(ctx, req.Config)...)
+
+	var condition types.String
+	var aboveGoal types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("alert_condition"), &condition)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("alert_above_goal"), &aboveGoal)...)
+	if resp.Diagnostics.HasError() || condition.IsUnknown() || condition.IsNull() || aboveGoal.IsUnknown() {
+		return
+	}
+
+	if condition.ValueString() == client.AlertConditionGoal && aboveGoal.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("alert_above_goal"),
+			"Missing alert_above_goal",
+			"You must provide whether to alert above or below the goal when using a goal alert.",
+		)
+	} else if condition.ValueString() == client.AlertConditionRows && !aboveGoal.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("alert_above_goal"),
+			"Invalid alert_above_goal",
+			"The alert_above_goal can only be provided when using a goal alert.",
+		)
+	}
+}
+
+func (a *AlertResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying, or if the provider hasn't been configured yet
+	if req.Plan.Raw.IsNull() || a.provider.api == nil {
+		return
+	}
+
+	var plan AlertModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.AlertCondition.ValueString() != client.AlertConditionGoal || plan.CardId.IsUnknown() {
+		return
+	}
+
+	cardId := plan.CardId.ValueInt64()
+	card, err := a.provider.api.Card.Get(ctx, cardId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("card_id"),
+			fmt.Sprintf("Error fetching card with ID %d", cardId),
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	if err := checkGoalAlertSupported(card); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("alert_condition"),
+			"Goal alerts are not supported",
+			fmt.Sprintf("The card cannot be used with a goal alert: %s.", err.Error()),
+		)
+	}
+}
+
+func (a *AlertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AlertModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := buildAlertRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alert, err := a.provider.api.Alert.Create(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating alert",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapAlertToState(ctx, alert, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(a.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (a *AlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AlertModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Alerts which are only sent once are archived after being sent, so are treated as deleted
+	alertId := state.Id.ValueInt64()
+	alert, err := a.provider.api.Alert.Get(ctx, alertId)
+	if err == nil && alert.Archived {
+		err = client.ErrNotFound
+	}
+	if err != nil {
+		diags = utils.HandleResourceReadError(ctx, "alert", alertId, err, resp)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(mapAlertToState(ctx, alert, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(a.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func (a *AlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AlertModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := buildAlertRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alertId := plan.Id.ValueInt64()
+	alert, err := a.provider.api.Alert.Update(ctx, alertId, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error updating alert with ID %d", alertId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapAlertToState(ctx, alert, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(a.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (a *AlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AlertModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alertId := state.Id.ValueInt64()
+	err := a.provider.api.Alert.Archive(ctx, alertId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error archiving alert with ID %d", alertId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (a *AlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	alertId, diags := a.provider.resolveImportId(ctx, req, "the numeric ID of the alert")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alert, err := a.provider.api.Alert.Get(ctx, alertId)
+	if err == nil && alert.Archived {
+		err = fmt.Errorf("alert %d has been archived", alertId)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing alert with ID %d", alertId),
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	var state AlertModel
+	resp.Diagnostics.Append(mapAlertToState(ctx, alert, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(a.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+// checkGoalAlertSupported returns an error if the card can't be used with a goal alert, either because its display
+// doesn't have a goal line or because the goal hasn't been set.
+func checkGoalAlertSupported(card *client.Card) error {
+	if !slices.Contains(goalAlertDisplays, card.Display) {
+		return fmt.Errorf("the card is displayed as a %s, but goal alerts are only supported by line, area, bar and progress cards", card.Display)
+	}
+
+	// Progress bars always have a goal, but the charts need one to be configured
+	if card.Display != "progress" && card.VisualizationSettings["graph.goal_value"] == nil {
+		return fmt.Errorf("the card does not have a goal line")
+	}
+
+	return nil
+}
+
+func buildAlertRequest(ctx context.Context, plan AlertModel) (*client.AlertRequest, diag.Diagnostics) {
+	channels, diags := buildNotificationChannels(ctx, plan.Email, plan.Slack, plan.Schedule)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &client.AlertRequest{
+		Card:           client.AlertCard{Id: plan.CardId.ValueInt64()},
+		AlertCondition: plan.AlertCondition.ValueString(),
+		AlertFirstOnly: plan.AlertFirstOnly.ValueBool(),
+		AlertAboveGoal: plan.AlertAboveGoal.ValueBoolPointer(),
+		Channels:       channels,
+	}, diags
+}
+
+func mapAlertToState(ctx context.Context, alert *client.Alert, target *AlertModel) diag.Diagnostics {
+	target.Id = types.Int64Value(alert.Id)
+	target.CardId = types.Int64Value(alert.Card.Id)
+	target.AlertCondition = types.StringValue(alert.AlertCondition)
+	target.AlertFirstOnly = types.BoolValue(alert.AlertFirstOnly)
+	target.CreatorId = types.Int64Value(alert.CreatorId)
+	target.CreatedAt = types.StringValue(alert.CreatedAt)
+
+	// Metabase may default the direction for rows alerts, which can't be configured
+	if alert.AlertCondition == client.AlertConditionGoal {
+		target.AlertAboveGoal = types.BoolPointerValue(alert.AlertAboveGoal)
+	} else {
+		target.AlertAboveGoal = types.BoolNull()
+	}
+
+	return mapNotificationChannelsToState(ctx, alert.Channels, &target.Email, &target.Slack, &target.Schedule)
+}
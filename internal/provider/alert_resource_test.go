@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"terraform-provider-metabase/internal/client"
+	"testing"
+)
+
+func TestCheckGoalAlertSupported(t *testing.T) {
+	t.Parallel()
+
+	t.Run("a chart with a goal should be supported", func(t *testing.T) {
+		err := checkGoalAlertSupported(&client.Card{
+			Display:               "line",
+			VisualizationSettings: map[string]any{"graph.goal_value": 100},
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("a progress bar should be supported", func(t *testing.T) {
+		err := checkGoalAlertSupported(&client.Card{Display: "progress"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("a chart without a goal should not be supported", func(t *testing.T) {
+		err := checkGoalAlertSupported(&client.Card{
+			Display:               "bar",
+			VisualizationSettings: map[string]any{},
+		})
+
+		assert.ErrorContains(t, err, "does not have a goal line")
+	})
+
+	t.Run("a table should not be supported", func(t *testing.T) {
+		err := checkGoalAlertSupported(&client.Card{Display: "table"})
+
+		assert.ErrorContains(t, err, "displayed as a table")
+	})
+}
+
+func TestAccAlertResource_Basic(t *testing.T) {
+	cardId := testAccCreateCard(t, testAccApiClient(t), acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_alert" "test" {
+	card_id         = %d
+	alert_condition = "rows"
+	email           = { user_ids = [1] }
+	schedule        = { type = "daily", hour = 8 }
+}
+`, cardId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("metabase_alert.test", "id"),
+					resource.TestCheckResourceAttr("metabase_alert.test", "card_id", fmt.Sprint(cardId)),
+					resource.TestCheckResourceAttr("metabase_alert.test", "alert_condition", "rows"),
+					resource.TestCheckResourceAttr("metabase_alert.test", "schedule.hour", "8"),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_alert" "test" {
+	card_id         = %d
+	alert_condition = "rows"
+	email           = { user_ids = [1] }
+	schedule        = { type = "hourly" }
+}
+`, cardId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_alert.test", "schedule.type", "hourly"),
+				),
+			},
+			{
+				ResourceName:      "metabase_alert.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAlertResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "metabase_alert" "test" {
+	card_id         = 1
+	alert_condition = "goal"
+	email           = { user_ids = [1] }
+	schedule        = { type = "daily", hour = 8 }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing alert_above_goal"),
+			},
+			{
+				Config: providerConfig + `
+resource "metabase_alert" "test" {
+	card_id          = 1
+	alert_condition  = "rows"
+	alert_above_goal = true
+	email            = { user_ids = [1] }
+	schedule         = { type = "daily", hour = 8 }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid alert_above_goal"),
+			},
+			{
+				Config: providerConfig + `
+resource "metabase_alert" "test" {
+	card_id         = 1
+	alert_condition = "rows"
+	email           = {}
+	schedule        = { type = "hourly" }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing email recipients"),
+			},
+		},
+	})
+}
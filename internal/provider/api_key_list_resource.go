@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ list.ListResource = &ApiKeyListResource{}
+
+type ApiKeyListResource struct {
+	provider *MetabaseProvider
+}
+
+func (l *ApiKeyListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (l *ApiKeyListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.ApiKeyListResource()
+}
+
+func (l *ApiKeyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	apiKeys, err := l.provider.api.ApiKey.List(ctx)
+	if err != nil {
+		stream.Results = listError("Failed to list API keys", err)
+		return
+	}
+
+	stream.Results = streamListResults(ctx, req, apiKeys, func(apiKey client.ApiKey, result *list.ListResult) {
+		// As with importing, the unmasked key is not available
+		state := ApiKeyModel{
+			Key:              types.StringNull(),
+			RotationTriggers: types.MapNull(types.StringType),
+		}
+		mapApiKeyToState(&apiKey, &state)
+
+		result.DisplayName = apiKey.Name
+		l.provider.setListResult(ctx, req, result, apiKey.Id, state)
+	})
+}
@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ApiKeyResource{}
+var _ resource.ResourceWithIdentity = &ApiKeyResource{}
+var _ resource.ResourceWithImportState = &ApiKeyResource{}
+var _ resource.ResourceWithModifyPlan = &ApiKeyResource{}
+
+type ApiKeyResource struct {
+	provider *MetabaseProvider
+}
+
+type ApiKeyModel struct {
+	Id               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	GroupId          types.Int64  `tfsdk:"group_id"`
+	RotationTriggers types.Map    `tfsdk:"rotation_triggers"`
+
+	Key         types.String `tfsdk:"key"`
+	MaskedKey   types.String `tfsdk:"masked_key"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+	UpdatedById types.Int64  `tfsdk:"updated_by_id"`
+}
+
+func (k *ApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (k *ApiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.ApiKeyResource()
+}
+
+func (k *ApiKeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schema.ResourceIdentity("API key")
+}
+
+func (k *ApiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when creating or destroying
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ApiKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing the rotation triggers will regenerate the key
+	if !plan.RotationTriggers.Equal(state.RotationTriggers) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("key"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("masked_key"), types.StringUnknown())...)
+	}
+}
+
+func (k *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ApiKeyModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey, err := k.provider.api.ApiKey.Create(ctx, &client.CreateApiKeyRequest{
+		Name:    plan.Name.ValueString(),
+		GroupId: plan.GroupId.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating API key",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	mapApiKeyToState(apiKey, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(k.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (k *ApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ApiKeyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKeyId := state.Id.ValueInt64()
+	apiKey, err := k.provider.api.ApiKey.Get(ctx, apiKeyId)
+	if err != nil {
+		diags = utils.HandleResourceReadError(ctx, "API key", apiKeyId, err, resp)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	mapApiKeyToState(apiKey, &state)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(k.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func (k *ApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ApiKeyModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ApiKeyModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKeyId := state.Id.ValueInt64()
+	err := k.provider.api.ApiKey.Update(ctx, apiKeyId, &client.UpdateApiKeyRequest{
+		Name:    plan.Name.ValueStringPointer(),
+		GroupId: plan.GroupId.ValueInt64Pointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error updating API key with ID %d", apiKeyId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	var regenerated *client.ApiKey
+	if !plan.RotationTriggers.Equal(state.RotationTriggers) {
+		regenerated, err = k.provider.api.ApiKey.Regenerate(ctx, apiKeyId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error updating API key with ID %d", apiKeyId),
+				fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+			)
+			return
+		}
+	}
+
+	apiKey, err := k.provider.api.ApiKey.Get(ctx, apiKeyId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error updating API key with ID %d", apiKeyId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+	mergeRegeneratedApiKey(apiKey, regenerated)
+
+	plan.Key = state.Key
+	mapApiKeyToState(apiKey, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(k.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (k *ApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ApiKeyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKeyId := state.Id.ValueInt64()
+	err := k.provider.api.ApiKey.Delete(ctx, apiKeyId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error deleting API key with ID %d", apiKeyId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (k *ApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	apiKeyId, diags := k.provider.resolveImportId(ctx, req, "the numeric ID of the API key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey, err := k.provider.api.ApiKey.Get(ctx, apiKeyId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing API key with ID %d", apiKeyId),
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	state := ApiKeyModel{
+		Key:              types.StringNull(),
+		RotationTriggers: types.MapNull(types.StringType),
+	}
+	mapApiKeyToState(apiKey, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(k.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func mapApiKeyToState(apiKey *client.ApiKey, target *ApiKeyModel) {
+	target.Id = types.Int64Value(apiKey.Id)
+	target.Name = types.StringValue(apiKey.Name)
+	target.GroupId = types.Int64Value(apiKey.Group.Id)
+
+	// The unmasked key is only returned when created or regenerated, so otherwise retain the existing value
+	if apiKey.UnmaskedKey != nil {
+		target.Key = types.StringValue(*apiKey.UnmaskedKey)
+	} else if target.Key.IsUnknown() {
+		target.Key = types.StringNull()
+	}
+
+	target.MaskedKey = types.StringValue(apiKey.MaskedKey)
+	target.CreatedAt = types.StringValue(apiKey.CreatedAt)
+	target.UpdatedAt = types.StringValue(apiKey.UpdatedAt)
+
+	if apiKey.UpdatedBy != nil {
+		target.UpdatedById = types.Int64Value(apiKey.UpdatedBy.Id)
+	} else {
+		target.UpdatedById = types.Int64Null()
+	}
+}
+
+// mergeRegeneratedApiKey copies the new keys from the response when regenerating an API key, which doesn't include any
+// of the other details, into the existing API key.
+func mergeRegeneratedApiKey(apiKey *client.ApiKey, regenerated *client.ApiKey) {
+	if regenerated == nil {
+		return
+	}
+
+	apiKey.UnmaskedKey = regenerated.UnmaskedKey
+	apiKey.MaskedKey = regenerated.MaskedKey
+}
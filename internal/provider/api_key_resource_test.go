@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-metabase/internal/client"
+	"testing"
+)
+
+func TestAccApiKeyResource_Basic(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_api_key" "test" {
+	name     = "%s"
+	group_id = 1
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("metabase_api_key.test", "id"),
+					resource.TestCheckResourceAttr("metabase_api_key.test", "name", name),
+					resource.TestCheckResourceAttr("metabase_api_key.test", "group_id", "1"),
+					resource.TestCheckResourceAttrSet("metabase_api_key.test", "key"),
+					resource.TestCheckResourceAttrSet("metabase_api_key.test", "masked_key"),
+					resource.TestCheckResourceAttrSet("metabase_api_key.test", "created_at"),
+					resource.TestCheckResourceAttrSet("metabase_api_key.test", "updated_at"),
+				),
+			},
+			{
+				ResourceName:            "metabase_api_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key"},
+			},
+		},
+	})
+}
+
+func TestAccApiKeyResource_Update(t *testing.T) {
+	originalName := acctest.RandString(10)
+	updatedName := acctest.RandString(11)
+	var originalKey string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_api_key" "test" {
+	name     = "%s"
+	group_id = 1
+}
+`, originalName),
+				Check: testAccStoreResourceAttr("metabase_api_key.test", "key", &originalKey),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_permissions_group" "test" {
+	name = "%s"
+}
+resource "metabase_api_key" "test" {
+	name     = "%s"
+	group_id = metabase_permissions_group.test.id
+}
+`, updatedName, updatedName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_api_key.test", "name", updatedName),
+					resource.TestCheckResourceAttrPair("metabase_api_key.test", "group_id", "metabase_permissions_group.test", "id"),
+					testAccCheckResourceAttrEquals("metabase_api_key.test", "key", &originalKey),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApiKeyResource_Rotate(t *testing.T) {
+	name := acctest.RandString(10)
+	var originalKey string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_api_key" "test" {
+	name     = "%s"
+	group_id = 1
+
+	rotation_triggers = {
+		version = "1"
+	}
+}
+`, name),
+				Check: testAccStoreResourceAttr("metabase_api_key.test", "key", &originalKey),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_api_key" "test" {
+	name     = "%s"
+	group_id = 1
+
+	rotation_triggers = {
+		version = "2"
+	}
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("metabase_api_key.test", "key"),
+					resource.TestCheckResourceAttr("metabase_api_key.test", "rotation_triggers.version", "2"),
+					func(s *terraform.State) error {
+						if err := testAccCheckResourceAttrEquals("metabase_api_key.test", "key", &originalKey)(s); err == nil {
+							return fmt.Errorf("expected the key to have been regenerated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestMergeRegeneratedApiKey(t *testing.T) {
+	t.Parallel()
+
+	existing := func() *client.ApiKey {
+		return &client.ApiKey{
+			Id:        4,
+			Name:      "CI",
+			Group:     client.ApiKeyGroup{Id: 2, Name: "Analysts"},
+			MaskedKey: "mb_old****",
+			UpdatedBy: &client.ApiKeyActor{Id: 1, CommonName: "Admin"},
+			CreatedAt: "2024-01-01T00:00:00Z",
+			UpdatedAt: "2024-02-01T00:00:00Z",
+		}
+	}
+
+	t.Run("only the keys should be taken from the regenerate response", func(t *testing.T) {
+		var regenerated client.ApiKey
+		err := json.Unmarshal([]byte(`{"id":4,"unmasked_key":"mb_newkey","masked_key":"mb_new****","prefix":"mb_new"}`), &regenerated)
+		assert.NoError(t, err)
+
+		apiKey := existing()
+		mergeRegeneratedApiKey(apiKey, &regenerated)
+
+		state := ApiKeyModel{Key: types.StringValue("mb_oldkey")}
+		mapApiKeyToState(apiKey, &state)
+
+		assert.Equal(t, types.Int64Value(4), state.Id)
+		assert.Equal(t, types.StringValue("CI"), state.Name)
+		assert.Equal(t, types.Int64Value(2), state.GroupId)
+		assert.Equal(t, types.StringValue("mb_newkey"), state.Key)
+		assert.Equal(t, types.StringValue("mb_new****"), state.MaskedKey)
+		assert.Equal(t, types.StringValue("2024-01-01T00:00:00Z"), state.CreatedAt)
+		assert.Equal(t, types.StringValue("2024-02-01T00:00:00Z"), state.UpdatedAt)
+		assert.Equal(t, types.Int64Value(1), state.UpdatedById)
+	})
+
+	t.Run("the existing key should be kept when not regenerated", func(t *testing.T) {
+		apiKey := existing()
+		mergeRegeneratedApiKey(apiKey, nil)
+
+		state := ApiKeyModel{Key: types.StringValue("mb_oldkey")}
+		mapApiKeyToState(apiKey, &state)
+
+		assert.Equal(t, types.StringValue("mb_oldkey"), state.Key)
+		assert.Equal(t, types.StringValue("mb_old****"), state.MaskedKey)
+	})
+}
+
+func testAccStoreResourceAttr(resourceName string, attribute string, target *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		*target = rs.Primary.Attributes[attribute]
+		return nil
+	}
+}
+
+func testAccCheckResourceAttrEquals(resourceName string, attribute string, expected *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return resource.TestCheckResourceAttr(resourceName, attribute, *expected)(s)
+	}
+}
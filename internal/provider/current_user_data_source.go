@@ -31,7 +31,7 @@ func (t *CurrentUserDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	var data UserResourceModel
+	var data UserModel
 	mapUserToState(currentUserDetails, &data)
 
 	diags := resp.State.Set(ctx, &data)
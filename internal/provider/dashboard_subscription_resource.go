@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &DashboardSubscriptionResource{}
+var _ resource.ResourceWithIdentity = &DashboardSubscriptionResource{}
+var _ resource.ResourceWithImportState = &DashboardSubscriptionResource{}
+var _ resource.ResourceWithValidateConfig = &DashboardSubscriptionResource{}
+
+type DashboardSubscriptionResource struct {
+	provider *MetabaseProvider
+}
+
+type DashboardSubscriptionModel struct {
+	Id          types.Int64  `tfsdk:"id"`
+	DashboardId types.Int64  `tfsdk:"dashboard_id"`
+	Name        types.String `tfsdk:"name"`
+	Cards       types.List   `tfsdk:"cards"`
+	Email       types.Object `tfsdk:"email"`
+	Slack       types.Object `tfsdk:"slack"`
+	Schedule    types.Object `tfsdk:"schedule"`
+	SkipIfEmpty types.Bool   `tfsdk:"skip_if_empty"`
+	Parameters  types.String `tfsdk:"parameters"`
+	CreatorId   types.Int64  `tfsdk:"creator_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+type DashboardSubscriptionCardModel struct {
+	CardId          types.Int64 `tfsdk:"card_id"`
+	DashboardCardId types.Int64 `tfsdk:"dashboard_card_id"`
+	IncludeCsv      types.Bool  `tfsdk:"include_csv"`
+	IncludeXls      types.Bool  `tfsdk:"include_xls"`
+}
+
+func (s *DashboardSubscriptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_subscription"
+}
+
+func (s *DashboardSubscriptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.DashboardSubscriptionResource()
+}
+
+func (s *DashboardSubscriptionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schema.ResourceIdentity("dashboard subscription")
+}
+
+func (s *DashboardSubscriptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateNotificationChannels(ctx, req.Config)...)
+}
+
+func (s *DashboardSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DashboardSubscriptionModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := buildPulseRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pulse, err := s.provider.api.Pulse.Create(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating dashboard subscription",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapPulseToState(ctx, pulse, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(s.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (s *DashboardSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DashboardSubscriptionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pulseId := state.Id.ValueInt64()
+	pulse, err := s.provider.api.Pulse.Get(ctx, pulseId)
+	if err == nil && pulse.Archived {
+		err = client.ErrNotFound
+	}
+	if err != nil {
+		diags = utils.HandleResourceReadError(ctx, "dashboard subscription", pulseId, err, resp)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(mapPulseToState(ctx, pulse, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(s.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func (s *DashboardSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DashboardSubscriptionModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := buildPulseRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pulseId := plan.Id.ValueInt64()
+	pulse, err := s.provider.api.Pulse.Update(ctx, pulseId, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error updating dashboard subscription with ID %d", pulseId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapPulseToState(ctx, pulse, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(s.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (s *DashboardSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DashboardSubscriptionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pulseId := state.Id.ValueInt64()
+	err := s.provider.api.Pulse.Archive(ctx, pulseId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error archiving dashboard subscription with ID %d", pulseId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (s *DashboardSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	pulseId, diags := s.provider.resolveImportId(ctx, req, "the numeric ID of the dashboard subscription")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pulse, err := s.provider.api.Pulse.Get(ctx, pulseId)
+	if err == nil && pulse.DashboardId == nil {
+		err = fmt.Errorf("pulse %d is not a dashboard subscription", pulseId)
+	} else if err == nil && pulse.Archived {
+		err = fmt.Errorf("dashboard subscription %d has been archived", pulseId)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing dashboard subscription with ID %d", pulseId),
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	state := DashboardSubscriptionModel{
+		Parameters: types.StringNull(),
+	}
+	resp.Diagnostics.Append(mapPulseToState(ctx, pulse, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(s.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func buildPulseRequest(ctx context.Context, plan DashboardSubscriptionModel) (*client.PulseRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var cardModels []DashboardSubscriptionCardModel
+	diags.Append(plan.Cards.ElementsAs(ctx, &cardModels, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	cards := make([]client.PulseCard, len(cardModels))
+	for i, card := range cardModels {
+		cards[i] = client.PulseCard{
+			Id:              card.CardId.ValueInt64(),
+			DashboardCardId: card.DashboardCardId.ValueInt64Pointer(),
+			IncludeCsv:      card.IncludeCsv.ValueBool(),
+			IncludeXls:      card.IncludeXls.ValueBool(),
+		}
+	}
+
+	channels, channelDiags := buildNotificationChannels(ctx, plan.Email, plan.Slack, plan.Schedule)
+	diags.Append(channelDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	parameters := make([]map[string]any, 0)
+	if !plan.Parameters.IsNull() {
+		if err := json.Unmarshal([]byte(plan.Parameters.ValueString()), &parameters); err != nil {
+			diags.AddAttributeError(
+				path.Root("parameters"),
+				"Invalid parameters",
+				fmt.Sprintf("The parameters must be a JSON list of objects: %s", err.Error()),
+			)
+			return nil, diags
+		}
+	}
+
+	return &client.PulseRequest{
+		Name:        plan.Name.ValueString(),
+		DashboardId: plan.DashboardId.ValueInt64Pointer(),
+		Cards:       cards,
+		Channels:    channels,
+		SkipIfEmpty: plan.SkipIfEmpty.ValueBool(),
+		Parameters:  parameters,
+	}, diags
+}
+
+func mapPulseToState(ctx context.Context, pulse *client.Pulse, target *DashboardSubscriptionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	target.Id = types.Int64Value(pulse.Id)
+	target.DashboardId = types.Int64PointerValue(pulse.DashboardId)
+	target.Name = types.StringValue(pulse.Name)
+	target.SkipIfEmpty = types.BoolValue(pulse.SkipIfEmpty)
+	target.CreatorId = types.Int64Value(pulse.CreatorId)
+	target.CreatedAt = types.StringValue(pulse.CreatedAt)
+
+	cards := make([]DashboardSubscriptionCardModel, len(pulse.Cards))
+	for i, card := range pulse.Cards {
+		cards[i] = DashboardSubscriptionCardModel{
+			CardId:          types.Int64Value(card.Id),
+			DashboardCardId: types.Int64PointerValue(card.DashboardCardId),
+			IncludeCsv:      types.BoolValue(card.IncludeCsv),
+			IncludeXls:      types.BoolValue(card.IncludeXls),
+		}
+	}
+	cardsValue, cardsDiags := types.ListValueFrom(ctx, schema.DashboardSubscriptionCardType, cards)
+	diags.Append(cardsDiags...)
+	target.Cards = cardsValue
+
+	diags.Append(mapNotificationChannelsToState(ctx, pulse.Channels, &target.Email, &target.Slack, &target.Schedule)...)
+
+	// Only update the parameters when their values have changed, so formatting differences don't cause a diff
+	if len(pulse.Parameters) == 0 {
+		if target.Parameters.IsUnknown() || !utils.JsonEquivalent(target.Parameters.ValueString(), "[]") {
+			target.Parameters = types.StringNull()
+		}
+	} else {
+		parameters, err := json.Marshal(pulse.Parameters)
+		if err != nil {
+			diags.AddError("Error processing parameters", fmt.Sprintf("An error occurred: %s", err.Error()))
+		} else if target.Parameters.IsNull() || target.Parameters.IsUnknown() || !utils.JsonEquivalent(target.Parameters.ValueString(), string(parameters)) {
+			target.Parameters = types.StringValue(string(parameters))
+		}
+	}
+
+	return diags
+}
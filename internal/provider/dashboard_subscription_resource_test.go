@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"terraform-provider-metabase/internal/client"
+	"testing"
+)
+
+func TestMapPulseToState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	id2, id4 := int64(2), int64(4)
+	pulse := &client.Pulse{
+		Id:          1,
+		Name:        "Weekly report",
+		DashboardId: &id2,
+		Cards:       []client.PulseCard{{Id: 3, DashboardCardId: &id4, IncludeCsv: true}},
+		Parameters:  []map[string]any{{"id": "abc", "value": []any{"a"}}},
+	}
+
+	t.Run("equivalent parameters should not be changed", func(t *testing.T) {
+		state := DashboardSubscriptionModel{
+			Parameters: types.StringValue(`[ { "value": ["a"], "id": "abc" } ]`),
+		}
+
+		diags := mapPulseToState(ctx, pulse, &state)
+
+		assert.Empty(t, diags)
+		assert.Equal(t, `[ { "value": ["a"], "id": "abc" } ]`, state.Parameters.ValueString())
+		assert.Equal(t, int64(2), state.DashboardId.ValueInt64())
+		assert.Len(t, state.Cards.Elements(), 1)
+	})
+
+	t.Run("changed parameters should be updated", func(t *testing.T) {
+		state := DashboardSubscriptionModel{
+			Parameters: types.StringValue(`[{"id": "abc", "value": ["b"]}]`),
+		}
+
+		diags := mapPulseToState(ctx, pulse, &state)
+
+		assert.Empty(t, diags)
+		assert.Equal(t, `[{"id":"abc","value":["a"]}]`, state.Parameters.ValueString())
+	})
+
+	t.Run("no parameters should be null", func(t *testing.T) {
+		state := DashboardSubscriptionModel{
+			Parameters: types.StringUnknown(),
+		}
+
+		diags := mapPulseToState(ctx, &client.Pulse{Id: 1}, &state)
+
+		assert.Empty(t, diags)
+		assert.True(t, state.Parameters.IsNull())
+	})
+}
+
+func TestAccDashboardSubscriptionResource_Basic(t *testing.T) {
+	api := testAccApiClient(t)
+	name := acctest.RandString(10)
+	cardId := testAccCreateCard(t, api, name)
+	dashboardId, dashboardCardId := testAccCreateDashboard(t, api, name, cardId)
+
+	config := func(subscriptionName string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "metabase_dashboard_subscription" "test" {
+	dashboard_id = %d
+	name         = "%s"
+	cards        = [{ card_id = %d, dashboard_card_id = %d }]
+	email        = { addresses = ["someone@example.com"] }
+	schedule     = { type = "daily", hour = 8 }
+}
+`, dashboardId, subscriptionName, cardId, dashboardCardId)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("metabase_dashboard_subscription.test", "id"),
+					resource.TestCheckResourceAttr("metabase_dashboard_subscription.test", "dashboard_id", fmt.Sprint(dashboardId)),
+					resource.TestCheckResourceAttr("metabase_dashboard_subscription.test", "cards.0.card_id", fmt.Sprint(cardId)),
+					resource.TestCheckResourceAttr("metabase_dashboard_subscription.test", "email.addresses.0", "someone@example.com"),
+				),
+			},
+			{
+				Config: config(name + " (renamed)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_dashboard_subscription.test", "name", name+" (renamed)"),
+				),
+			},
+			{
+				ResourceName:      "metabase_dashboard_subscription.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDashboardSubscriptionResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "metabase_dashboard_subscription" "test" {
+	dashboard_id = 1
+	name         = "Test"
+	cards        = [{ card_id = 1, dashboard_card_id = 1 }]
+	schedule     = { type = "daily", hour = 8 }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing notification channel"),
+			},
+			{
+				Config: providerConfig + `
+resource "metabase_dashboard_subscription" "test" {
+	dashboard_id = 1
+	name         = "Test"
+	cards        = [{ card_id = 1, dashboard_card_id = 1 }]
+	email        = { addresses = ["someone@example.com"] }
+	schedule     = { type = "weekly", hour = 8 }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing schedule attribute"),
+			},
+		},
+	})
+}
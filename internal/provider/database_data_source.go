@@ -2,16 +2,26 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"github.com/bnjns/metabase-sdk-go/service/database"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
 	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/validators"
+)
+
+var (
+	errDatabaseNotFound       = errors.New("no database matches the provided name")
+	errMultipleDatabasesFound = errors.New("more than one database matches the provided name, so the engine must also be provided")
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ datasource.DataSource = &DatabaseDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &DatabaseDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &DatabaseDataSource{}
 
 type DatabaseDataSourceModel struct {
 	Id     types.Int64  `tfsdk:"id"`
@@ -35,6 +45,29 @@ func (d DatabaseDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 	resp.Schema = schema.DatabaseDataSource()
 }
 
+func (d DatabaseDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		validators.ExactlyOneOfValidator("id", "name"),
+	}
+}
+
+func (d DatabaseDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var state DatabaseDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Engine.IsNull() && !state.Id.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("engine"),
+			"Invalid attribute combination",
+			"The engine can only be provided when looking up the database by name.",
+		)
+	}
+}
+
 func (d DatabaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state DatabaseDataSourceModel
 	diags := req.Config.Get(ctx, &state)
@@ -43,8 +76,29 @@ func (d DatabaseDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	if !state.Name.IsNull() {
+		databases, err := d.provider.api.Database.List(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to list databases",
+				fmt.Sprintf("An error occurred: %s", err.Error()),
+			)
+			return
+		}
+
+		match, err := matchDatabase(databases, state.Name.ValueString(), state.Engine.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to find database",
+				fmt.Sprintf("An error occurred: %s", err.Error()),
+			)
+			return
+		}
+		state.Id = types.Int64Value(match.Id)
+	}
+
 	databaseId := state.Id.ValueInt64()
-	db, err := d.provider.client.Database.Get(ctx, databaseId)
+	db, err := d.provider.api.Database.Get(ctx, databaseId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error fetching database with ID: %d", databaseId),
@@ -63,20 +117,39 @@ func (d DatabaseDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	resp.Diagnostics.Append(diags...)
 }
 
-func mapDatabaseToDataSource(ctx context.Context, db *database.Database, target *DatabaseDataSourceModel) diag.Diagnostics {
+func mapDatabaseToDataSource(ctx context.Context, db *client.Database, target *DatabaseDataSourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	target.Engine = types.StringValue(string(db.Engine))
 	target.Name = types.StringValue(db.Name)
 	target.Features, _ = types.ListValueFrom(ctx, types.StringType, db.Features)
 
-	schedules, scheduleDiags := buildSchedules(db)
+	schedules, scheduleDiags := buildSchedules(&db.Database)
 	target.Schedules = schedules
 	diags.Append(scheduleDiags...)
 
-	details, _, detailsDiags := buildDatabaseDetails(db)
+	details, _, detailsDiags := buildDatabaseDetails(&db.Database)
 	target.Details = details
 	diags.Append(detailsDiags...)
 
 	return diags
 }
+
+// matchDatabase returns the single database with the given name and, if not empty, engine, erroring if there is not
+// exactly one match.
+func matchDatabase(databases []client.Database, name string, engine string) (*client.Database, error) {
+	matches := make([]client.Database, 0, 1)
+	for _, db := range databases {
+		if db.Name == name && (engine == "" || string(db.Engine) == engine) {
+			matches = append(matches, db)
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("%w: %s", errDatabaseNotFound, name)
+	} else if len(matches) > 1 {
+		return nil, fmt.Errorf("%w: %s", errMultipleDatabasesFound, name)
+	}
+
+	return &matches[0], nil
+}
@@ -1,10 +1,56 @@
 package provider
 
 import (
+	"github.com/bnjns/metabase-sdk-go/service/database"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"terraform-provider-metabase/internal/client"
 	"testing"
 )
 
+func TestMatchDatabase(t *testing.T) {
+	t.Parallel()
+
+	databases := []client.Database{
+		{Database: database.Database{Id: 1, Name: "Warehouse", Engine: database.EnginePostgres}},
+		{Database: database.Database{Id: 2, Name: "Warehouse", Engine: "mysql"}},
+		{Database: database.Database{Id: 3, Name: "Sample Database", Engine: "h2"}},
+	}
+
+	t.Run("a unique name should match without an engine", func(t *testing.T) {
+		db, err := matchDatabase(databases, "Sample Database", "")
+
+		assert.Nil(t, err)
+		if assert.NotNil(t, db) {
+			assert.Equal(t, int64(3), db.Id)
+		}
+	})
+
+	t.Run("the engine should narrow down duplicate names", func(t *testing.T) {
+		db, err := matchDatabase(databases, "Warehouse", "mysql")
+
+		assert.Nil(t, err)
+		if assert.NotNil(t, db) {
+			assert.Equal(t, int64(2), db.Id)
+		}
+	})
+
+	t.Run("duplicate names without an engine should return an error", func(t *testing.T) {
+		db, err := matchDatabase(databases, "Warehouse", "")
+
+		assert.Nil(t, db)
+		assert.ErrorIs(t, err, errMultipleDatabasesFound)
+	})
+
+	t.Run("no matches should return an error", func(t *testing.T) {
+		db, err := matchDatabase(databases, "Sample Database", "postgres")
+
+		assert.Nil(t, db)
+		assert.ErrorIs(t, err, errDatabaseNotFound)
+	})
+}
+
 func TestAccDatabaseDataSource_Basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -38,3 +84,40 @@ data "metabase_database" "test" {
 		},
 	})
 }
+
+func TestAccDatabaseDataSource_Name(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "metabase_database" "test" {
+	name   = "Sample Database"
+	engine = "h2"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.metabase_database.test", "id", "1"),
+					resource.TestCheckResourceAttr("data.metabase_database.test", "engine", "h2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseDataSource_IdAndEngine(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "metabase_database" "test" {
+	id     = 1
+	engine = "h2"
+}
+`,
+				ExpectError: regexp.MustCompile("The engine can only be provided when looking up the database by name"),
+			},
+		},
+	})
+}
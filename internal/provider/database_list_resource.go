@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ list.ListResource = &DatabaseListResource{}
+
+type DatabaseListResource struct {
+	provider *MetabaseProvider
+}
+
+type DatabaseListResourceModel struct {
+	Engine        types.String `tfsdk:"engine"`
+	IncludeSample types.Bool   `tfsdk:"include_sample"`
+}
+
+func (l *DatabaseListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+func (l *DatabaseListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.DatabaseListResource()
+}
+
+func (l *DatabaseListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config DatabaseListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	databases, err := l.provider.api.Database.List(ctx)
+	if err != nil {
+		stream.Results = listError("Failed to list databases", err)
+		return
+	}
+
+	databases = filterDatabases(databases, &config)
+	stream.Results = streamListResults(ctx, req, databases, func(db client.Database, result *list.ListResult) {
+		var state DatabaseModel
+		result.Diagnostics.Append(mapDatabaseToState(ctx, &db, &state)...)
+
+		result.DisplayName = db.Name
+		l.provider.setListResult(ctx, req, result, db.Id, state)
+	})
+}
+
+// filterDatabases removes the audit database, which cannot be managed, and any databases not matching the filters,
+// and sorts the databases by ID so the results are stable.
+func filterDatabases(databases []client.Database, config *DatabaseListResourceModel) []client.Database {
+	filtered := make([]client.Database, 0, len(databases))
+	for _, db := range databases {
+		if db.IsAudit || (db.IsSample && !config.IncludeSample.ValueBool()) {
+			continue
+		}
+		if !config.Engine.IsNull() && string(db.Engine) != config.Engine.ValueString() {
+			continue
+		}
+
+		filtered = append(filtered, db)
+	}
+
+	slices.SortFunc(filtered, func(a, b client.Database) bool {
+		return a.Id < b.Id
+	})
+
+	return filtered
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/transforms"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &DatabaseMetadataDataSource{}
+
+type DatabaseMetadataDataSource struct {
+	provider *MetabaseProvider
+}
+
+type DatabaseMetadataModel struct {
+	DatabaseId types.Int64  `tfsdk:"database_id"`
+	Schema     types.String `tfsdk:"schema"`
+	TableName  types.String `tfsdk:"table_name"`
+
+	Schemas []types.String               `tfsdk:"schemas"`
+	Tables  []DatabaseMetadataTableModel `tfsdk:"tables"`
+}
+
+type DatabaseMetadataTableModel struct {
+	Id             types.Int64                  `tfsdk:"id"`
+	Schema         types.String                 `tfsdk:"schema"`
+	Name           types.String                 `tfsdk:"name"`
+	DisplayName    types.String                 `tfsdk:"display_name"`
+	VisibilityType types.String                 `tfsdk:"visibility_type"`
+	Fields         []DatabaseMetadataFieldModel `tfsdk:"fields"`
+}
+
+type DatabaseMetadataFieldModel struct {
+	Id              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	DisplayName     types.String `tfsdk:"display_name"`
+	BaseType        types.String `tfsdk:"base_type"`
+	SemanticType    types.String `tfsdk:"semantic_type"`
+	FkTargetFieldId types.Int64  `tfsdk:"fk_target_field_id"`
+}
+
+func (d *DatabaseMetadataDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_metadata"
+}
+
+func (d *DatabaseMetadataDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.DatabaseMetadataDataSource()
+}
+
+func (d *DatabaseMetadataDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DatabaseMetadataModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databaseId := state.DatabaseId.ValueInt64()
+	metadata, err := d.provider.api.Database.GetMetadata(ctx, databaseId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error fetching metadata for database with ID: %d", databaseId),
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	mapDatabaseMetadataToState(metadata, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func mapDatabaseMetadataToState(metadata *client.DatabaseMetadata, target *DatabaseMetadataModel) {
+	schemas := make([]string, 0)
+	tables := make([]DatabaseMetadataTableModel, 0)
+
+	for _, table := range metadata.Tables {
+		if !target.Schema.IsNull() && (table.Schema == nil || *table.Schema != target.Schema.ValueString()) {
+			continue
+		}
+		if !target.TableName.IsNull() && table.Name != target.TableName.ValueString() {
+			continue
+		}
+
+		if table.Schema != nil && !slices.Contains(schemas, *table.Schema) {
+			schemas = append(schemas, *table.Schema)
+		}
+
+		fields := make([]DatabaseMetadataFieldModel, len(table.Fields))
+		for i, field := range table.Fields {
+			fields[i] = DatabaseMetadataFieldModel{
+				Id:              types.Int64Value(field.Id),
+				Name:            types.StringValue(field.Name),
+				DisplayName:     types.StringValue(field.DisplayName),
+				BaseType:        types.StringValue(field.BaseType),
+				SemanticType:    transforms.ToTerraformString(field.SemanticType),
+				FkTargetFieldId: transforms.ToTerraformInt(field.FkTargetFieldId),
+			}
+		}
+
+		tables = append(tables, DatabaseMetadataTableModel{
+			Id:             types.Int64Value(table.Id),
+			Schema:         transforms.ToTerraformString(table.Schema),
+			Name:           types.StringValue(table.Name),
+			DisplayName:    types.StringValue(table.DisplayName),
+			VisibilityType: transforms.ToTerraformString(table.VisibilityType),
+			Fields:         fields,
+		})
+	}
+
+	slices.Sort(schemas)
+
+	target.Schemas = make([]types.String, len(schemas))
+	for i, s := range schemas {
+		target.Schemas[i] = types.StringValue(s)
+	}
+	target.Tables = tables
+}
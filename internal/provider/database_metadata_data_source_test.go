@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-metabase/internal/client"
+	"testing"
+)
+
+func TestMapDatabaseMetadataToState(t *testing.T) {
+	t.Parallel()
+
+	public := "public"
+	reporting := "reporting"
+	metadata := &client.DatabaseMetadata{
+		Id: 1,
+		Tables: []client.Table{
+			{Id: 1, Schema: &reporting, Name: "orders", DisplayName: "Orders"},
+			{Id: 2, Schema: &public, Name: "orders", DisplayName: "Orders", Fields: []client.Field{
+				{Id: 10, Name: "id", DisplayName: "ID", BaseType: "type/Integer"},
+			}},
+			{Id: 3, Schema: &public, Name: "customers", DisplayName: "Customers"},
+		},
+	}
+
+	t.Run("no filters should include all tables", func(t *testing.T) {
+		state := DatabaseMetadataModel{
+			Schema:    types.StringNull(),
+			TableName: types.StringNull(),
+		}
+
+		mapDatabaseMetadataToState(metadata, &state)
+
+		assert.Equal(t, []types.String{types.StringValue("public"), types.StringValue("reporting")}, state.Schemas)
+		assert.Len(t, state.Tables, 3)
+	})
+
+	t.Run("schema filter should only include tables in that schema", func(t *testing.T) {
+		state := DatabaseMetadataModel{
+			Schema:    types.StringValue("public"),
+			TableName: types.StringNull(),
+		}
+
+		mapDatabaseMetadataToState(metadata, &state)
+
+		assert.Equal(t, []types.String{types.StringValue("public")}, state.Schemas)
+		assert.Len(t, state.Tables, 2)
+	})
+
+	t.Run("schema and table filters should only include the matching table", func(t *testing.T) {
+		state := DatabaseMetadataModel{
+			Schema:    types.StringValue("public"),
+			TableName: types.StringValue("orders"),
+		}
+
+		mapDatabaseMetadataToState(metadata, &state)
+
+		if assert.Len(t, state.Tables, 1) {
+			assert.Equal(t, int64(2), state.Tables[0].Id.ValueInt64())
+			if assert.Len(t, state.Tables[0].Fields, 1) {
+				assert.Equal(t, int64(10), state.Tables[0].Fields[0].Id.ValueInt64())
+				assert.True(t, state.Tables[0].Fields[0].SemanticType.IsNull())
+			}
+		}
+	})
+}
+
+func TestAccDatabaseMetadataDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "metabase_database_metadata" "test" {
+	database_id = %d
+}
+`, testAccSampleDatabaseId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.metabase_database_metadata.test", "schemas.#", "1"),
+					resource.TestCheckResourceAttr("data.metabase_database_metadata.test", "schemas.0", "PUBLIC"),
+					resource.TestCheckResourceAttrSet("data.metabase_database_metadata.test", "tables.0.id"),
+					resource.TestCheckResourceAttrSet("data.metabase_database_metadata.test", "tables.0.fields.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseMetadataDataSource_Filtered(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "metabase_database_metadata" "test" {
+	database_id = %d
+	schema      = "PUBLIC"
+	table_name  = "ORDERS"
+}
+`, testAccSampleDatabaseId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.metabase_database_metadata.test", "tables.#", "1"),
+					resource.TestCheckResourceAttr("data.metabase_database_metadata.test", "tables.0.name", "ORDERS"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.metabase_database_metadata.test", "tables.0.fields.*", map[string]string{
+						"name":          "ID",
+						"semantic_type": "type/PK",
+					}),
+				),
+			},
+		},
+	})
+}
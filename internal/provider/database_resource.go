@@ -13,7 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"golang.org/x/exp/slices"
 	"regexp"
-	"strconv"
+	"strings"
+	"terraform-provider-metabase/internal/client"
 	"terraform-provider-metabase/internal/schema"
 	"terraform-provider-metabase/internal/transforms"
 	"terraform-provider-metabase/internal/utils"
@@ -28,10 +29,13 @@ type DatabaseModel struct {
 	Details       types.String `tfsdk:"details"`
 	DetailsSecure types.String `tfsdk:"details_secure"`
 	Schedules     types.Object `tfsdk:"schedules"`
+
+	ActionsEnabled types.Bool `tfsdk:"actions_enabled"`
 }
 
 // Ensure provider fully satisfies the framework interfaces
 var _ resource.Resource = &DatabaseResource{}
+var _ resource.ResourceWithIdentity = &DatabaseResource{}
 var _ resource.ResourceWithImportState = &DatabaseResource{}
 
 var (
@@ -57,6 +61,10 @@ func (d *DatabaseResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema.DatabaseResource()
 }
 
+func (d *DatabaseResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schema.ResourceIdentity("database")
+}
+
 func (d *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan DatabaseModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -84,6 +92,21 @@ func (d *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// The settings can't be provided when creating the database, so must be set separately
+	if plan.ActionsEnabled.ValueBool() {
+		db, err := d.provider.api.Database.Get(ctx, databaseId)
+		if err == nil {
+			err = d.updateSettings(ctx, db, plan)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error updating database with ID %d", databaseId),
+				fmt.Sprintf("An error occurred when updating the database settings: %s", err.Error()),
+			)
+			return
+		}
+	}
+
 	state, diags := d.fetchDatabaseState(ctx, databaseId, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -92,6 +115,7 @@ func (d *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(d.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
 }
 
 func (d *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -103,7 +127,7 @@ func (d *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	databaseId := state.Id.ValueInt64()
-	db, err := d.provider.client.Database.Get(ctx, databaseId)
+	db, err := d.provider.api.Database.Get(ctx, databaseId)
 	if err != nil {
 		diags = utils.HandleResourceReadError(ctx, "database", databaseId, err, resp)
 		resp.Diagnostics.Append(diags...)
@@ -111,6 +135,7 @@ func (d *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 	diags = mapDatabaseToState(ctx, db, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(d.provider.setResourceIdentity(ctx, resp.Identity, databaseId)...)
 }
 
 func (d *DatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -128,7 +153,7 @@ func (d *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	db, err := d.provider.client.Database.Get(ctx, databaseId)
+	db, err := d.provider.api.Database.Get(ctx, databaseId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error updating database with ID %d", databaseId),
@@ -137,6 +162,7 @@ func (d *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	// The settings are updated separately, as the SDK only supports settings with string values
 	err = d.provider.client.Database.Update(ctx, databaseId, &database.UpdateRequest{
 		Name:             plan.Name.ValueStringPointer(),
 		Engine:           &db.Engine,
@@ -147,7 +173,6 @@ func (d *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 		PointsOfInterest: db.PointsOfInterest,
 		AutoRunQueries:   &db.AutoRunQueries,
 		CacheTTL:         db.CacheTTL,
-		Settings:         db.Settings,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -157,6 +182,15 @@ func (d *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	err = d.updateSettings(ctx, db, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error updating database with ID %d", databaseId),
+			fmt.Sprintf("An error occurred when updating the database settings: %s", err.Error()),
+		)
+		return
+	}
+
 	state, diags := d.fetchDatabaseState(ctx, databaseId, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -164,6 +198,7 @@ func (d *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(d.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
 }
 
 func (d *DatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -186,7 +221,11 @@ func (d *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (d *DatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	databaseId, _ := strconv.ParseInt(req.ID, 10, 64)
+	databaseId, diags := d.resolveImportId(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	plan := DatabaseModel{
 		Details:       types.StringUnknown(),
@@ -200,6 +239,37 @@ func (d *DatabaseResource) ImportState(ctx context.Context, req resource.ImportS
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(d.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+// resolveImportId converts the import ID, which can either be the ID of the database or its name, or the identity into
+// the database ID.
+func (d *DatabaseResource) resolveImportId(ctx context.Context, req resource.ImportStateRequest) (int64, diag.Diagnostics) {
+	importId := req.ID
+	if isIdentityImport(req) || utils.IsNumericImportId(importId) || strings.TrimSpace(importId) == "" {
+		return d.provider.resolveImportId(ctx, req, "the numeric ID or name of the database")
+	}
+
+	match, err := d.findDatabaseByName(ctx, importId)
+	if err != nil {
+		return 0, diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				fmt.Sprintf("Error importing database %q", importId),
+				fmt.Sprintf("An error occurred: %s", err.Error()),
+			),
+		}
+	}
+
+	return match.Id, nil
+}
+
+func (d *DatabaseResource) findDatabaseByName(ctx context.Context, name string) (*client.Database, error) {
+	databases, err := d.provider.api.Database.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return matchDatabase(databases, name, "")
 }
 
 func (d *DatabaseModel) buildDatabaseDetails() (database.Details, diag.Diagnostics) {
@@ -250,8 +320,24 @@ func (d *DatabaseModel) buildDatabaseDetails() (database.Details, diag.Diagnosti
 	return detailsCombined, nil
 }
 
+// updateSettings updates the settings managed by the provider, retaining any other settings the database has. The
+// settings are only updated if they are configured and have changed.
+func (d *DatabaseResource) updateSettings(ctx context.Context, db *client.Database, plan DatabaseModel) error {
+	if plan.ActionsEnabled.IsNull() || plan.ActionsEnabled.IsUnknown() || plan.ActionsEnabled.ValueBool() == db.ActionsEnabled() {
+		return nil
+	}
+
+	settings := make(map[string]any, len(db.Settings)+1)
+	for k, v := range db.Settings {
+		settings[k] = v
+	}
+	settings[client.DatabaseSettingEnableActions] = plan.ActionsEnabled.ValueBool()
+
+	return d.provider.api.Database.UpdateSettings(ctx, db.Id, settings)
+}
+
 func (d *DatabaseResource) fetchDatabaseState(ctx context.Context, databaseId int64, plan DatabaseModel) (DatabaseModel, diag.Diagnostics) {
-	db, err := d.provider.client.Database.Get(ctx, databaseId)
+	db, err := d.provider.api.Database.Get(ctx, databaseId)
 	if err != nil {
 		return DatabaseModel{}, diag.Diagnostics{
 			diag.NewErrorDiagnostic(
@@ -276,19 +362,20 @@ func (d *DatabaseResource) fetchDatabaseState(ctx context.Context, databaseId in
 	return state, diags
 }
 
-func mapDatabaseToState(ctx context.Context, db *database.Database, target *DatabaseModel) diag.Diagnostics {
+func mapDatabaseToState(ctx context.Context, db *client.Database, target *DatabaseModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	target.Id = types.Int64Value(db.Id)
 	target.Engine = types.StringValue(string(db.Engine))
 	target.Name = types.StringValue(db.Name)
 	target.Features, _ = types.ListValueFrom(ctx, types.StringType, db.Features)
+	target.ActionsEnabled = types.BoolValue(db.ActionsEnabled())
 
-	schedules, scheduleDiags := buildSchedules(db)
+	schedules, scheduleDiags := buildSchedules(&db.Database)
 	target.Schedules = schedules
 	diags.Append(scheduleDiags...)
 
-	details, detailsSecure, detailsDiags := buildDatabaseDetails(db)
+	details, detailsSecure, detailsDiags := buildDatabaseDetails(&db.Database)
 	target.Details = details
 	target.DetailsSecure = detailsSecure
 	diags.Append(detailsDiags...)
@@ -296,7 +383,9 @@ func mapDatabaseToState(ctx context.Context, db *database.Database, target *Data
 	return diags
 }
 
-func isSensitiveDatabaseDetail(key string, value interface{}) bool {
+// IsSensitiveDatabaseDetail returns whether a database detail is sensitive, either because it is known to be or because
+// Metabase has redacted its value.
+func IsSensitiveDatabaseDetail(key string, value interface{}) bool {
 	if slices.Contains(sensitiveDatabaseDetails, key) {
 		return true
 	}
@@ -306,11 +395,12 @@ func isSensitiveDatabaseDetail(key string, value interface{}) bool {
 		return false
 	}
 
-	if redactedPattern.MatchString(valueStr) {
-		return true
-	}
+	return isRedactedValue(valueStr)
+}
 
-	return false
+// isRedactedValue returns whether a value has been redacted by Metabase, which replaces secrets with **...**.
+func isRedactedValue(value string) bool {
+	return redactedPattern.MatchString(value)
 }
 
 func buildDatabaseDetails(db *database.Database) (types.String, types.String, diag.Diagnostics) {
@@ -323,7 +413,7 @@ func buildDatabaseDetails(db *database.Database) (types.String, types.String, di
 	details := make(map[string]any)
 	detailsSecure := make(map[string]any)
 	for k, v := range *db.Details {
-		if isSensitiveDatabaseDetail(k, v) {
+		if IsSensitiveDatabaseDetail(k, v) {
 			detailsSecure[k] = v
 		} else {
 			details[k] = v
@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"context"
 	"github.com/bnjns/metabase-sdk-go/service/database"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/stretchr/testify/assert"
+	"terraform-provider-metabase/internal/client"
 	"testing"
 )
 
@@ -13,25 +15,25 @@ func TestIsSensitiveDatabaseDetail(t *testing.T) {
 	t.Parallel()
 
 	t.Run("detail with sensitive key should be sensitive", func(t *testing.T) {
-		result := isSensitiveDatabaseDetail("password", "example")
+		result := IsSensitiveDatabaseDetail("password", "example")
 
 		assert.True(t, result)
 	})
 
 	t.Run("detail with a non-string value should not be sensitive", func(t *testing.T) {
-		result := isSensitiveDatabaseDetail("port", 5432)
+		result := IsSensitiveDatabaseDetail("port", 5432)
 
 		assert.False(t, result)
 	})
 
 	t.Run("detail with a redacted string value should be sensitive", func(t *testing.T) {
-		result := isSensitiveDatabaseDetail("field", "**MetabasePass**")
+		result := IsSensitiveDatabaseDetail("field", "**MetabasePass**")
 
 		assert.True(t, result)
 	})
 
 	t.Run("detail with a non-redacted string value should not be sensitive", func(t *testing.T) {
-		result := isSensitiveDatabaseDetail("host", "localhost")
+		result := IsSensitiveDatabaseDetail("host", "localhost")
 
 		assert.False(t, result)
 	})
@@ -166,6 +168,31 @@ func TestBuildDatabaseDetails(t *testing.T) {
 	})
 }
 
+func TestMapDatabaseToState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("a database without settings should not have actions enabled", func(t *testing.T) {
+		var state DatabaseModel
+		diags := mapDatabaseToState(ctx, &client.Database{Database: database.Database{Id: 1}}, &state)
+
+		assert.Empty(t, diags)
+		assert.False(t, state.ActionsEnabled.ValueBool())
+	})
+
+	t.Run("a database with the actions setting should have actions enabled", func(t *testing.T) {
+		var state DatabaseModel
+		diags := mapDatabaseToState(ctx, &client.Database{
+			Database: database.Database{Id: 1},
+			Settings: map[string]any{client.DatabaseSettingEnableActions: true},
+		}, &state)
+
+		assert.Empty(t, diags)
+		assert.True(t, state.ActionsEnabled.ValueBool())
+	})
+}
+
 func TestAccDatabaseResource_PostgreSQL(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -193,6 +220,7 @@ resource "metabase_database" "test" {
 					resource.TestCheckResourceAttr("metabase_database.test", "name", "Test PostgreSQL"),
 					resource.TestCheckResourceAttrSet("metabase_database.test", "details"),
 					resource.TestCheckResourceAttrSet("metabase_database.test", "details_secure"),
+					resource.TestCheckResourceAttr("metabase_database.test", "actions_enabled", "false"),
 				),
 			},
 			{
@@ -201,6 +229,13 @@ resource "metabase_database" "test" {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: ignoredDatabaseImportAttributes,
 			},
+			{
+				ResourceName:            "metabase_database.test",
+				ImportState:             true,
+				ImportStateId:           "Test PostgreSQL",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: ignoredDatabaseImportAttributes,
+			},
 			{
 				Config: providerConfig + `
 resource "metabase_database" "test" {
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &DatabasesDataSource{}
+
+type DatabasesDataSource struct {
+	provider *MetabaseProvider
+}
+
+type DatabasesModel struct {
+	Databases []DatabasesItemModel `tfsdk:"databases"`
+}
+
+type DatabasesItemModel struct {
+	DatabaseDataSourceModel
+	IsSample          types.Bool   `tfsdk:"is_sample"`
+	IsAudit           types.Bool   `tfsdk:"is_audit"`
+	InitialSyncStatus types.String `tfsdk:"initial_sync_status"`
+	IsSyncing         types.Bool   `tfsdk:"is_syncing"`
+}
+
+func (d *DatabasesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_databases"
+}
+
+func (d *DatabasesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.DatabasesDataSource()
+}
+
+func (d *DatabasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	databases, err := d.provider.api.Database.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list databases",
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	var state DatabasesModel
+	diags := mapDatabasesToState(ctx, databases, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func mapDatabasesToState(ctx context.Context, databases []client.Database, target *DatabasesModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	slices.SortFunc(databases, func(a, b client.Database) bool {
+		return a.Id < b.Id
+	})
+
+	target.Databases = make([]DatabasesItemModel, len(databases))
+	for i, db := range databases {
+		item := &target.Databases[i]
+		item.Id = types.Int64Value(db.Id)
+		diags.Append(mapDatabaseToDataSource(ctx, &db, &item.DatabaseDataSourceModel)...)
+
+		item.IsSample = types.BoolValue(db.IsSample)
+		item.IsAudit = types.BoolValue(db.IsAudit)
+		item.InitialSyncStatus = types.StringValue(db.InitialSyncStatus)
+		item.IsSyncing = types.BoolValue(db.InitialSyncStatus == "incomplete")
+	}
+
+	return diags
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"github.com/bnjns/metabase-sdk-go/service/database"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-metabase/internal/client"
+	"testing"
+)
+
+func TestMapDatabasesToState(t *testing.T) {
+	t.Parallel()
+
+	databases := []client.Database{
+		{Database: database.Database{Id: 13, Name: "Internal Metabase Database", Engine: database.EnginePostgres, InitialSyncStatus: "complete"}, IsAudit: true},
+		{Database: database.Database{Id: 2, Name: "Warehouse", Engine: database.EnginePostgres, InitialSyncStatus: "incomplete"}},
+		{Database: database.Database{Id: 1, Name: "Sample Database", Engine: "h2", IsSample: true, InitialSyncStatus: "complete"}},
+	}
+	var state DatabasesModel
+
+	diags := mapDatabasesToState(context.Background(), databases, &state)
+
+	assert.False(t, diags.HasError())
+	if assert.Len(t, state.Databases, 3) {
+		assert.Equal(t, int64(1), state.Databases[0].Id.ValueInt64())
+		assert.True(t, state.Databases[0].IsSample.ValueBool())
+		assert.Equal(t, "h2", state.Databases[0].Engine.ValueString())
+
+		assert.Equal(t, "Warehouse", state.Databases[1].Name.ValueString())
+		assert.True(t, state.Databases[1].IsSyncing.ValueBool())
+
+		assert.True(t, state.Databases[2].IsAudit.ValueBool())
+		assert.False(t, state.Databases[2].IsSyncing.ValueBool())
+	}
+}
+
+func TestAccDatabasesDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "metabase_databases" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.metabase_databases.test", "databases.*", map[string]string{
+						"id":        "1",
+						"engine":    "h2",
+						"is_sample": "true",
+					}),
+				),
+			},
+		},
+	})
+}
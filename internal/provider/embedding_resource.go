@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+	"strings"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/utils"
+	"terraform-provider-metabase/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &EmbeddingResource{}
+var _ resource.ResourceWithConfigValidators = &EmbeddingResource{}
+var _ resource.ResourceWithValidateConfig = &EmbeddingResource{}
+var _ resource.ResourceWithImportState = &EmbeddingResource{}
+var _ resource.ResourceWithIdentity = &EmbeddingResource{}
+
+type EmbeddingResource struct {
+	provider *MetabaseProvider
+}
+
+type EmbeddingModel struct {
+	Id              types.String `tfsdk:"id"`
+	CardId          types.Int64  `tfsdk:"card_id"`
+	DashboardId     types.Int64  `tfsdk:"dashboard_id"`
+	EnableEmbedding types.Bool   `tfsdk:"enable_embedding"`
+	EmbeddingParams types.Map    `tfsdk:"embedding_params"`
+}
+
+func (e *EmbeddingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_embedding"
+}
+
+func (e *EmbeddingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.EmbeddingResource()
+}
+
+func (e *EmbeddingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schema.SharedObjectIdentity()
+}
+
+func (e *EmbeddingResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.ExactlyOneOfValidator("card_id", "dashboard_id"),
+	}
+}
+
+func (e *EmbeddingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var params types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("embedding_params"), &params)...)
+	if resp.Diagnostics.HasError() || params.IsNull() || params.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateEmbeddingParams(params)...)
+}
+
+func (e *EmbeddingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EmbeddingModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := buildEmbeddingRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	object := newSharedObject(plan.CardId, plan.DashboardId)
+	sharing, err := e.provider.sharingApi(object).UpdateEmbedding(ctx, object.id, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error enabling embedding",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	diags = mapEmbeddingToState(ctx, object, sharing, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(e.provider.setSharedObjectIdentity(ctx, resp.Identity, object)...)
+}
+
+func (e *EmbeddingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EmbeddingModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	object := newSharedObject(state.CardId, state.DashboardId)
+	sharing, err := e.provider.getSharing(ctx, object)
+	if err != nil {
+		diags = utils.HandleResourceReadError(ctx, object.objectType, object.id, err, resp)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	diags = mapEmbeddingToState(ctx, object, sharing, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(e.provider.setSharedObjectIdentity(ctx, resp.Identity, object)...)
+}
+
+func (e *EmbeddingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EmbeddingModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := buildEmbeddingRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	object := newSharedObject(plan.CardId, plan.DashboardId)
+	sharing, err := e.provider.sharingApi(object).UpdateEmbedding(ctx, object.id, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error updating embedding for %s", object),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	diags = mapEmbeddingToState(ctx, object, sharing, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(e.provider.setSharedObjectIdentity(ctx, resp.Identity, object)...)
+}
+
+func (e *EmbeddingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EmbeddingModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	object := newSharedObject(state.CardId, state.DashboardId)
+	_, err := e.provider.sharingApi(object).UpdateEmbedding(ctx, object.id, &client.EmbeddingRequest{
+		EnableEmbedding: false,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error disabling embedding for %s", object),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (e *EmbeddingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	object, diags := e.provider.resolveSharedObjectImport(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sharing, err := e.provider.getSharing(ctx, object)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing embedding for %s", object),
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	state := EmbeddingModel{EmbeddingParams: types.MapNull(types.StringType)}
+	diags = mapEmbeddingToState(ctx, object, sharing, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(e.provider.setSharedObjectIdentity(ctx, resp.Identity, object)...)
+}
+
+// validateEmbeddingParams checks that each of the parameters is either enabled, locked or disabled.
+func validateEmbeddingParams(params types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for slug, value := range params.Elements() {
+		str, ok := value.(types.String)
+		if !ok || str.IsUnknown() || str.IsNull() {
+			continue
+		}
+
+		if !slices.Contains(schema.EmbeddingParamValues, str.ValueString()) {
+			diags.AddAttributeError(
+				path.Root("embedding_params").AtMapKey(slug),
+				"Invalid embedding parameter",
+				fmt.Sprintf("The parameter %q must be one of %s, got: %q", slug, strings.Join(schema.EmbeddingParamValues, ", "), str.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
+
+func buildEmbeddingRequest(ctx context.Context, plan EmbeddingModel) (*client.EmbeddingRequest, diag.Diagnostics) {
+	params := make(map[string]string)
+	diags := plan.EmbeddingParams.ElementsAs(ctx, &params, false)
+
+	return &client.EmbeddingRequest{
+		EnableEmbedding: plan.EnableEmbedding.ValueBool(),
+		EmbeddingParams: params,
+	}, diags
+}
+
+func mapEmbeddingToState(ctx context.Context, object sharedObject, sharing *client.Sharing, target *EmbeddingModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	target.Id = types.StringValue(object.String())
+	target.CardId, target.DashboardId = object.attributes()
+	target.EnableEmbedding = types.BoolValue(sharing.EnableEmbedding)
+
+	// Keep an empty map if that's what was configured, rather than replacing it with null
+	if len(sharing.EmbeddingParams) == 0 {
+		if target.EmbeddingParams.IsUnknown() || len(target.EmbeddingParams.Elements()) > 0 {
+			target.EmbeddingParams = types.MapNull(types.StringType)
+		}
+	} else {
+		params, paramsDiags := types.MapValueFrom(ctx, types.StringType, sharing.EmbeddingParams)
+		diags.Append(paramsDiags...)
+		target.EmbeddingParams = params
+	}
+
+	return diags
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"terraform-provider-metabase/internal/client"
+	"testing"
+)
+
+func TestValidateEmbeddingParams(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid parameters should not return an error", func(t *testing.T) {
+		params := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"category": types.StringValue(client.EmbeddingParamEnabled),
+			"user_id":  types.StringValue(client.EmbeddingParamLocked),
+			"created":  types.StringValue(client.EmbeddingParamDisabled),
+			"unknown":  types.StringUnknown(),
+		})
+
+		assert.Empty(t, validateEmbeddingParams(params))
+	})
+
+	t.Run("an invalid parameter should return an error", func(t *testing.T) {
+		params := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"category": types.StringValue("hidden"),
+		})
+
+		diags := validateEmbeddingParams(params)
+
+		assert.Len(t, diags, 1)
+		assert.Equal(t, "Invalid embedding parameter", diags[0].Summary())
+	})
+}
+
+func TestMapEmbeddingToState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dashboard := sharedObject{objectType: sharedObjectDashboard, id: 2}
+
+	t.Run("the object should be identified", func(t *testing.T) {
+		state := EmbeddingModel{EmbeddingParams: types.MapNull(types.StringType)}
+
+		diags := mapEmbeddingToState(ctx, dashboard, &client.Sharing{Id: 2, EnableEmbedding: true}, &state)
+
+		assert.Empty(t, diags)
+		assert.Equal(t, "dashboard/2", state.Id.ValueString())
+		assert.True(t, state.CardId.IsNull())
+		assert.Equal(t, int64(2), state.DashboardId.ValueInt64())
+		assert.True(t, state.EnableEmbedding.ValueBool())
+		assert.True(t, state.EmbeddingParams.IsNull())
+	})
+
+	t.Run("configured empty parameters should be kept", func(t *testing.T) {
+		state := EmbeddingModel{EmbeddingParams: types.MapValueMust(types.StringType, map[string]attr.Value{})}
+
+		diags := mapEmbeddingToState(ctx, dashboard, &client.Sharing{Id: 2}, &state)
+
+		assert.Empty(t, diags)
+		assert.False(t, state.EmbeddingParams.IsNull())
+		assert.Empty(t, state.EmbeddingParams.Elements())
+	})
+
+	t.Run("parameters should be mapped", func(t *testing.T) {
+		state := EmbeddingModel{EmbeddingParams: types.MapNull(types.StringType)}
+
+		diags := mapEmbeddingToState(ctx, dashboard, &client.Sharing{
+			Id:              2,
+			EmbeddingParams: map[string]string{"category": client.EmbeddingParamLocked},
+		}, &state)
+
+		assert.Empty(t, diags)
+		assert.Equal(t, types.StringValue(client.EmbeddingParamLocked), state.EmbeddingParams.Elements()["category"])
+	})
+}
+
+func TestAccEmbeddingResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "metabase_embedding" "test" {
+	card_id      = 1
+	dashboard_id = 1
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid attribute combination"),
+			},
+			{
+				Config: providerConfig + `
+resource "metabase_embedding" "test" {
+	dashboard_id     = 1
+	embedding_params = { category = "hidden" }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid embedding parameter"),
+			},
+		},
+	})
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+)
+
+const embeddingSettingsId = "embedding"
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &EmbeddingSettingsResource{}
+var _ resource.ResourceWithModifyPlan = &EmbeddingSettingsResource{}
+
+type EmbeddingSettingsResource struct {
+	provider *MetabaseProvider
+}
+
+type EmbeddingSettingsModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	SecretKey            types.String `tfsdk:"embedding_secret_key"`
+	SecretKeyVersion     types.String `tfsdk:"embedding_secret_key_version"`
+	SecretKeyFingerprint types.String `tfsdk:"embedding_secret_key_fingerprint"`
+}
+
+func (e *EmbeddingSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_embedding_settings"
+}
+
+func (e *EmbeddingSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.EmbeddingSettingsResource()
+}
+
+func (e *EmbeddingSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when creating or destroying
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state EmbeddingSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The key is set again if the version changes or it was changed outside of Terraform, which Read records by
+	// clearing the fingerprint
+	if shouldSetEmbeddingSecretKey(plan, state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("embedding_secret_key_fingerprint"), types.StringUnknown())...)
+	}
+}
+
+func (e *EmbeddingSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EmbeddingSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var secretKey types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("embedding_secret_key"), &secretKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := e.provider.api.Setting.Set(ctx, client.SettingEmbeddingSecretKey, secretKey.ValueString())
+	if err == nil {
+		err = e.provider.api.Setting.Set(ctx, client.SettingEnableEmbedding, plan.Enabled.ValueBool())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error configuring embedding",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	state, diags := e.readSettings(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (e *EmbeddingSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EmbeddingSettingsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := e.readSettings(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.SecretKeyFingerprint.IsNull() && !newState.SecretKeyFingerprint.Equal(state.SecretKeyFingerprint) {
+		resp.Diagnostics.AddWarning(
+			"Embedding secret key changed outside of Terraform",
+			"The embedding secret key stored in Metabase no longer matches the key set by Terraform, so it will be set again.",
+		)
+		newState.SecretKeyFingerprint = types.StringNull()
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (e *EmbeddingSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state EmbeddingSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if shouldSetEmbeddingSecretKey(plan, state) {
+		var secretKey types.String
+		diags := req.Config.GetAttribute(ctx, path.Root("embedding_secret_key"), &secretKey)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := e.provider.api.Setting.Set(ctx, client.SettingEmbeddingSecretKey, secretKey.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating embedding settings",
+				fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+			)
+			return
+		}
+	}
+
+	err := e.provider.api.Setting.Set(ctx, client.SettingEnableEmbedding, plan.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating embedding settings",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	newState, diags := e.readSettings(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (e *EmbeddingSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	err := e.provider.api.Setting.Set(ctx, client.SettingEnableEmbedding, false)
+	if err == nil {
+		err = e.provider.api.Setting.Set(ctx, client.SettingEmbeddingSecretKey, nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error disabling embedding",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+}
+
+// readSettings fetches the current settings from Metabase, retaining the version from the given model.
+func (e *EmbeddingSettingsResource) readSettings(ctx context.Context, current EmbeddingSettingsModel) (EmbeddingSettingsModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var secretKey *string
+	var enabled *bool
+	for key, value := range map[string]any{
+		client.SettingEmbeddingSecretKey: &secretKey,
+		client.SettingEnableEmbedding:    &enabled,
+	} {
+		if err := e.provider.api.Setting.Get(ctx, key, value); err != nil {
+			diags.AddError(
+				"Error fetching embedding settings",
+				fmt.Sprintf("An error occurred: %s", err.Error()),
+			)
+			return current, diags
+		}
+	}
+
+	state := EmbeddingSettingsModel{
+		Id:                   types.StringValue(embeddingSettingsId),
+		Enabled:              types.BoolValue(enabled != nil && *enabled),
+		SecretKey:            types.StringNull(),
+		SecretKeyVersion:     current.SecretKeyVersion,
+		SecretKeyFingerprint: types.StringNull(),
+	}
+	if secretKey != nil && *secretKey != "" {
+		state.SecretKeyFingerprint = types.StringValue(secretFingerprint(*secretKey))
+	}
+
+	return state, diags
+}
+
+func shouldSetEmbeddingSecretKey(plan EmbeddingSettingsModel, state EmbeddingSettingsModel) bool {
+	return !plan.SecretKeyVersion.Equal(state.SecretKeyVersion) || state.SecretKeyFingerprint.IsNull()
+}
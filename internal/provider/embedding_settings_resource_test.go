@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestShouldSetEmbeddingSecretKey(t *testing.T) {
+	t.Parallel()
+
+	state := EmbeddingSettingsModel{
+		SecretKeyVersion:     types.StringValue("1"),
+		SecretKeyFingerprint: types.StringValue("**********ab12"),
+	}
+
+	t.Run("an unchanged version should not set the key", func(t *testing.T) {
+		assert.False(t, shouldSetEmbeddingSecretKey(EmbeddingSettingsModel{SecretKeyVersion: types.StringValue("1")}, state))
+	})
+
+	t.Run("a changed version should set the key", func(t *testing.T) {
+		assert.True(t, shouldSetEmbeddingSecretKey(EmbeddingSettingsModel{SecretKeyVersion: types.StringValue("2")}, state))
+	})
+
+	t.Run("a key changed outside of Terraform should be set again", func(t *testing.T) {
+		drifted := state
+		drifted.SecretKeyFingerprint = types.StringNull()
+
+		assert.True(t, shouldSetEmbeddingSecretKey(EmbeddingSettingsModel{SecretKeyVersion: types.StringValue("1")}, drifted))
+	})
+}
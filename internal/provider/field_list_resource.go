@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ list.ListResource = &FieldListResource{}
+
+type FieldListResource struct {
+	provider *MetabaseProvider
+}
+
+type FieldListResourceModel struct {
+	TableId types.Int64 `tfsdk:"table_id"`
+}
+
+func (l *FieldListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_field"
+}
+
+func (l *FieldListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.FieldListResource()
+}
+
+func (l *FieldListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config FieldListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	table, err := l.provider.api.Table.GetQueryMetadata(ctx, config.TableId.ValueInt64())
+	if err != nil {
+		stream.Results = listError("Failed to list fields", err)
+		return
+	}
+
+	stream.Results = streamListResults(ctx, req, table.Fields, func(field client.Field, result *list.ListResult) {
+		var state FieldModel
+		result.Diagnostics.Append(mapFieldToState(&field, &state)...)
+
+		result.DisplayName = table.Name + "." + field.Name
+		l.provider.setListResult(ctx, req, result, field.Id, state)
+	})
+}
@@ -0,0 +1,401 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/transforms"
+	"terraform-provider-metabase/internal/utils"
+)
+
+const fieldDefaultsPrivateKey = "defaults"
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &FieldResource{}
+var _ resource.ResourceWithIdentity = &FieldResource{}
+var _ resource.ResourceWithImportState = &FieldResource{}
+var _ resource.ResourceWithValidateConfig = &FieldResource{}
+
+type FieldResource struct {
+	provider *MetabaseProvider
+}
+
+type FieldModel struct {
+	Id       types.Int64  `tfsdk:"id"`
+	TableId  types.Int64  `tfsdk:"table_id"`
+	Name     types.String `tfsdk:"name"`
+	BaseType types.String `tfsdk:"base_type"`
+
+	DisplayName      types.String `tfsdk:"display_name"`
+	Description      types.String `tfsdk:"description"`
+	SemanticType     types.String `tfsdk:"semantic_type"`
+	FkTargetFieldId  types.Int64  `tfsdk:"fk_target_field_id"`
+	VisibilityType   types.String `tfsdk:"visibility_type"`
+	HasFieldValues   types.String `tfsdk:"has_field_values"`
+	CoercionStrategy types.String `tfsdk:"coercion_strategy"`
+	Settings         types.String `tfsdk:"settings"`
+	Dimension        types.Object `tfsdk:"dimension"`
+}
+
+type FieldDimensionModel struct {
+	Type                 types.String `tfsdk:"type"`
+	Name                 types.String `tfsdk:"name"`
+	HumanReadableFieldId types.Int64  `tfsdk:"human_readable_field_id"`
+}
+
+// fieldDefaults are the values of a field before it was managed by Terraform, which are restored when the resource is
+// destroyed.
+type fieldDefaults struct {
+	Request   client.UpdateFieldRequest `json:"request"`
+	Dimension *client.Dimension         `json:"dimension"`
+}
+
+func (f *FieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_field"
+}
+
+func (f *FieldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.FieldResource()
+}
+
+func (f *FieldResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schema.ResourceIdentity("field")
+}
+
+func (f *FieldResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var dimension *FieldDimensionModel
+	diags := req.Config.GetAttribute(ctx, path.Root("dimension"), &dimension)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || dimension == nil {
+		return
+	}
+
+	if dimension.Type.ValueString() == "external" && dimension.HumanReadableFieldId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dimension").AtName("human_readable_field_id"),
+			"Missing human_readable_field_id",
+			"You must provide the ID of the field to display when using external remapping.",
+		)
+	}
+}
+
+func (f *FieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan FieldModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fields are created by syncing the database, so we adopt the existing field
+	field, err := f.provider.api.Field.Find(ctx, plan.TableId.ValueInt64(), plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error finding field",
+			fmt.Sprintf("Unable to find the field. Check the database has been synced. An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	// Store the existing values so they can be restored when destroyed
+	diags = resp.Private.SetKey(ctx, fieldDefaultsPrivateKey, buildFieldDefaults(field))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map the existing field so that an existing dimension is only changed if it differs from the config
+	var existing FieldModel
+	diags = mapFieldToState(field, &existing)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := f.updateField(ctx, field.Id, plan, existing.Dimension)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(f.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func (f *FieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FieldModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fieldId := state.Id.ValueInt64()
+	field, err := f.provider.api.Field.Get(ctx, fieldId)
+	if err != nil {
+		diags = utils.HandleResourceReadError(ctx, "field", fieldId, err, resp)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	diags = mapFieldToState(field, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(f.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func (f *FieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan FieldModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priorState FieldModel
+	diags = req.State.Get(ctx, &priorState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := f.updateField(ctx, plan.Id.ValueInt64(), plan, priorState.Dimension)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(f.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func (f *FieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state FieldModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultsJson, diags := req.Private.GetKey(ctx, fieldDefaultsPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(defaultsJson) == 0 {
+		return
+	}
+
+	var defaults fieldDefaults
+	if err := json.Unmarshal(defaultsJson, &defaults); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to reset field",
+			fmt.Sprintf("The original values of the field could not be read, so it has been left as it is: %s", err.Error()),
+		)
+		return
+	}
+
+	fieldId := state.Id.ValueInt64()
+	err := f.provider.api.Field.Update(ctx, fieldId, &defaults.Request)
+	if err == nil {
+		if defaults.Dimension != nil {
+			err = f.provider.api.Field.SetDimension(ctx, fieldId, defaults.Dimension)
+		} else if !state.Dimension.IsNull() {
+			err = f.provider.api.Field.DeleteDimension(ctx, fieldId)
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error resetting field with ID %d", fieldId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (f *FieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fieldId, diags := f.provider.resolveImportId(ctx, req, "the numeric ID of the field")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field, err := f.provider.api.Field.Get(ctx, fieldId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing field with ID %d", fieldId),
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	diags = resp.Private.SetKey(ctx, fieldDefaultsPrivateKey, buildFieldDefaults(field))
+	resp.Diagnostics.Append(diags...)
+
+	var state FieldModel
+	diags = mapFieldToState(field, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(f.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+// updateField updates the metadata of the field to match the plan. The dimension is only set or deleted if it differs
+// from the prior dimension, to avoid removing any remapping from fields which don't have one.
+func (f *FieldResource) updateField(ctx context.Context, fieldId int64, plan FieldModel, priorDimension types.Object) (FieldModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request, err := buildUpdateFieldRequest(plan)
+	if err != nil {
+		diags.AddAttributeError(path.Root("settings"), "Configuration error", fmt.Sprintf("Error processing settings: %s", err.Error()))
+		return FieldModel{}, diags
+	}
+
+	err = f.provider.api.Field.Update(ctx, fieldId, request)
+	if err == nil && !plan.Dimension.Equal(priorDimension) {
+		if plan.Dimension.IsNull() {
+			err = f.provider.api.Field.DeleteDimension(ctx, fieldId)
+		} else {
+			var dimension FieldDimensionModel
+			diags.Append(plan.Dimension.As(ctx, &dimension, basetypes.ObjectAsOptions{})...)
+			err = f.provider.api.Field.SetDimension(ctx, fieldId, &client.Dimension{
+				Type:                 dimension.Type.ValueString(),
+				Name:                 dimension.Name.ValueString(),
+				HumanReadableFieldId: transforms.FromTerraformInt(dimension.HumanReadableFieldId),
+			})
+		}
+	}
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error updating field with ID %d", fieldId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return FieldModel{}, diags
+	}
+
+	field, err := f.provider.api.Field.Get(ctx, fieldId)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error fetching field with ID %d", fieldId),
+			fmt.Sprintf("An unexpected error occurred: %s", err.Error()),
+		)
+		return FieldModel{}, diags
+	}
+
+	// Use the planned settings so the state matches the config, provided they're equivalent
+	state := FieldModel{Settings: plan.Settings}
+	diags.Append(mapFieldToState(field, &state)...)
+
+	return state, diags
+}
+
+// buildUpdateFieldRequest creates the request to update the metadata of a field. The computed attributes are omitted
+// when they're unknown, so that the values inferred by the sync are kept when adopting a field.
+func buildUpdateFieldRequest(plan FieldModel) (*client.UpdateFieldRequest, error) {
+	settings, err := utils.UnmarshallJson(plan.Settings)
+	if err != nil {
+		return nil, err
+	}
+	var settingsPtr *map[string]interface{}
+	if settings != nil {
+		settingsPtr = &settings
+	}
+
+	var fkTargetFieldId *int64
+	if !plan.FkTargetFieldId.IsUnknown() {
+		fkTargetFieldId = transforms.FromTerraformInt(plan.FkTargetFieldId)
+	}
+
+	return &client.UpdateFieldRequest{
+		DisplayName:      transforms.FromTerraformString(plan.DisplayName),
+		Description:      transforms.FromTerraformString(plan.Description),
+		SemanticType:     transforms.FromTerraformString(plan.SemanticType),
+		FkTargetFieldId:  fkTargetFieldId,
+		VisibilityType:   transforms.FromTerraformString(plan.VisibilityType),
+		HasFieldValues:   transforms.FromTerraformString(plan.HasFieldValues),
+		CoercionStrategy: transforms.FromTerraformString(plan.CoercionStrategy),
+		Settings:         settingsPtr,
+	}, nil
+}
+
+func mapFieldToState(field *client.Field, target *FieldModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	target.Id = types.Int64Value(field.Id)
+	target.TableId = types.Int64Value(field.TableId)
+	target.Name = types.StringValue(field.Name)
+	target.BaseType = types.StringValue(field.BaseType)
+
+	target.DisplayName = types.StringValue(field.DisplayName)
+	target.Description = transforms.ToTerraformString(field.Description)
+	target.SemanticType = transforms.ToTerraformString(field.SemanticType)
+	target.FkTargetFieldId = transforms.ToTerraformInt(field.FkTargetFieldId)
+	target.VisibilityType = types.StringValue(field.VisibilityType)
+	target.HasFieldValues = types.StringValue(field.HasFieldValues)
+	target.CoercionStrategy = transforms.ToTerraformString(field.CoercionStrategy)
+
+	if field.Settings == nil || len(*field.Settings) == 0 {
+		target.Settings = types.StringNull()
+	} else {
+		settings, err := json.Marshal(field.Settings)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error parsing settings for field %d", field.Id), err.Error())
+		} else if target.Settings.IsNull() || target.Settings.IsUnknown() || !utils.JsonEquivalent(target.Settings.ValueString(), string(settings)) {
+			target.Settings = types.StringValue(string(settings))
+		}
+	}
+
+	if len(field.Dimensions) == 0 {
+		target.Dimension = types.ObjectNull(schema.FieldDimensionType.AttributeTypes())
+	} else {
+		dimension := field.Dimensions[0]
+		dimensionValue, dimensionDiags := types.ObjectValue(schema.FieldDimensionType.AttributeTypes(), map[string]attr.Value{
+			"type":                    types.StringValue(dimension.Type),
+			"name":                    types.StringValue(dimension.Name),
+			"human_readable_field_id": transforms.ToTerraformInt(dimension.HumanReadableFieldId),
+		})
+		target.Dimension = dimensionValue
+		diags.Append(dimensionDiags...)
+	}
+
+	return diags
+}
+
+func buildFieldDefaults(field *client.Field) []byte {
+	defaults := fieldDefaults{
+		Request: client.UpdateFieldRequest{
+			DisplayName:      &field.DisplayName,
+			Description:      field.Description,
+			SemanticType:     field.SemanticType,
+			FkTargetFieldId:  field.FkTargetFieldId,
+			VisibilityType:   &field.VisibilityType,
+			HasFieldValues:   &field.HasFieldValues,
+			CoercionStrategy: field.CoercionStrategy,
+			Settings:         field.Settings,
+		},
+	}
+	if len(field.Dimensions) > 0 {
+		dimension := field.Dimensions[0]
+		dimension.Id = 0
+		defaults.Dimension = &dimension
+	}
+
+	defaultsJson, _ := json.Marshal(defaults)
+	return defaultsJson
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const testAccSampleTablesConfig = `
+resource "metabase_table" "orders" {
+	database_id = 1
+	schema      = "PUBLIC"
+	name        = "ORDERS"
+}
+resource "metabase_table" "products" {
+	database_id = 1
+	schema      = "PUBLIC"
+	name        = "PRODUCTS"
+}
+`
+
+func TestBuildUpdateFieldRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("a field adopted without any overrides should keep the values inferred by the sync", func(t *testing.T) {
+		plan := FieldModel{
+			DisplayName:      types.StringUnknown(),
+			Description:      types.StringNull(),
+			SemanticType:     types.StringUnknown(),
+			FkTargetFieldId:  types.Int64Unknown(),
+			VisibilityType:   types.StringUnknown(),
+			HasFieldValues:   types.StringUnknown(),
+			CoercionStrategy: types.StringNull(),
+			Settings:         types.StringNull(),
+		}
+
+		request, err := buildUpdateFieldRequest(plan)
+		assert.NoError(t, err)
+
+		requestJson, err := json.Marshal(request)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"description":null,"coercion_strategy":null,"settings":null}`, string(requestJson))
+	})
+
+	t.Run("configured values should be sent", func(t *testing.T) {
+		plan := FieldModel{
+			DisplayName:      types.StringValue("Product"),
+			Description:      types.StringValue("The product ordered"),
+			SemanticType:     types.StringValue("type/FK"),
+			FkTargetFieldId:  types.Int64Value(12),
+			VisibilityType:   types.StringValue("normal"),
+			HasFieldValues:   types.StringValue("list"),
+			CoercionStrategy: types.StringNull(),
+			Settings:         types.StringValue(`{"currency_style":"code"}`),
+		}
+
+		request, err := buildUpdateFieldRequest(plan)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "type/FK", *request.SemanticType)
+		assert.Equal(t, int64(12), *request.FkTargetFieldId)
+		assert.Equal(t, map[string]interface{}{"currency_style": "code"}, *request.Settings)
+	})
+}
+
+func TestAccFieldResource_Adopt(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccSampleTablesConfig + `
+resource "metabase_field" "product_id" {
+	table_id = metabase_table.products.id
+	name     = "ID"
+}
+resource "metabase_field" "test" {
+	table_id = metabase_table.orders.id
+	name     = "PRODUCT_ID"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_field.product_id", "semantic_type", "type/PK"),
+					resource.TestCheckResourceAttr("metabase_field.test", "semantic_type", "type/FK"),
+					resource.TestCheckResourceAttrPair("metabase_field.test", "fk_target_field_id", "metabase_field.product_id", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFieldResource_Basic(t *testing.T) {
+	description := acctest.RandString(20)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccSampleTablesConfig + fmt.Sprintf(`
+resource "metabase_field" "test" {
+	table_id = metabase_table.orders.id
+	name     = "DISCOUNT"
+
+	display_name     = "Discount applied"
+	description      = "%s"
+	semantic_type    = "type/Discount"
+	visibility_type  = "details-only"
+	has_field_values = "none"
+	settings         = jsonencode({
+		currency_style = "code"
+	})
+}
+`, description),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("metabase_field.test", "id"),
+					resource.TestCheckResourceAttrSet("metabase_field.test", "base_type"),
+					resource.TestCheckResourceAttr("metabase_field.test", "display_name", "Discount applied"),
+					resource.TestCheckResourceAttr("metabase_field.test", "description", description),
+					resource.TestCheckResourceAttr("metabase_field.test", "semantic_type", "type/Discount"),
+					resource.TestCheckResourceAttr("metabase_field.test", "visibility_type", "details-only"),
+					resource.TestCheckResourceAttr("metabase_field.test", "has_field_values", "none"),
+					resource.TestCheckResourceAttr("metabase_field.test", "settings", `{"currency_style":"code"}`),
+				),
+			},
+			{
+				ResourceName:      "metabase_field.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFieldResource_ForeignKey(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccSampleTablesConfig + `
+resource "metabase_field" "product_id" {
+	table_id = metabase_table.products.id
+	name     = "ID"
+}
+resource "metabase_field" "product_title" {
+	table_id = metabase_table.products.id
+	name     = "TITLE"
+}
+resource "metabase_field" "test" {
+	table_id = metabase_table.orders.id
+	name     = "PRODUCT_ID"
+
+	semantic_type      = "type/FK"
+	fk_target_field_id = metabase_field.product_id.id
+
+	dimension = {
+		type                    = "external"
+		name                    = "Product"
+		human_readable_field_id = metabase_field.product_title.id
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_field.test", "semantic_type", "type/FK"),
+					resource.TestCheckResourceAttrPair("metabase_field.test", "fk_target_field_id", "metabase_field.product_id", "id"),
+					resource.TestCheckResourceAttr("metabase_field.test", "dimension.type", "external"),
+					resource.TestCheckResourceAttr("metabase_field.test", "dimension.name", "Product"),
+					resource.TestCheckResourceAttrPair("metabase_field.test", "dimension.human_readable_field_id", "metabase_field.product_title", "id"),
+				),
+			},
+			{
+				Config: providerConfig + testAccSampleTablesConfig + `
+resource "metabase_field" "product_id" {
+	table_id = metabase_table.products.id
+	name     = "ID"
+}
+resource "metabase_field" "test" {
+	table_id = metabase_table.orders.id
+	name     = "PRODUCT_ID"
+
+	semantic_type      = "type/FK"
+	fk_target_field_id = metabase_field.product_id.id
+}
+`,
+				Check: resource.TestCheckNoResourceAttr("metabase_field.test", "dimension"),
+			},
+		},
+	})
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-metabase/internal/utils"
+)
+
+type ResourceIdentityModel struct {
+	Id   types.Int64  `tfsdk:"id"`
+	Host types.String `tfsdk:"host"`
+}
+
+type UserIdentityModel struct {
+	ResourceIdentityModel
+	Email types.String `tfsdk:"email"`
+}
+
+// identityHost returns the host the provider is configured with, normalised so that it can be compared between runs.
+func (p *MetabaseProvider) identityHost() string {
+	return strings.TrimRight(p.host, "/")
+}
+
+// buildResourceIdentity creates the identity of the resource with the given ID in the configured Metabase instance.
+func (p *MetabaseProvider) buildResourceIdentity(id int64) ResourceIdentityModel {
+	return ResourceIdentityModel{
+		Id:   types.Int64Value(id),
+		Host: types.StringValue(p.identityHost()),
+	}
+}
+
+// setResourceIdentity records the identity of the resource with the given ID. The identity is only available when
+// Terraform supports resource identity, so this does nothing otherwise.
+func (p *MetabaseProvider) setResourceIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id int64) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, p.buildResourceIdentity(id))
+}
+
+// setUserIdentity records the identity of the user. Terraform does not allow the identity of an existing resource to
+// change, so the email address is only recorded the first time and is not updated if the user's email changes.
+func (p *MetabaseProvider) setUserIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, state *UserModel) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	email := state.Email
+	if !identity.Raw.IsNull() {
+		var current UserIdentityModel
+		diags.Append(identity.Get(ctx, &current)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if !current.Email.IsNull() && !current.Email.IsUnknown() {
+			email = current.Email
+		}
+	}
+
+	diags.Append(identity.Set(ctx, UserIdentityModel{
+		ResourceIdentityModel: p.buildResourceIdentity(state.Id.ValueInt64()),
+		Email:                 email,
+	})...)
+	return diags
+}
+
+// checkIdentityHost ensures that the host in an identity being imported matches the configured Metabase instance, so
+// that a resource from one instance cannot accidentally be imported using another.
+func (p *MetabaseProvider) checkIdentityHost(host types.String) diag.Diagnostics {
+	if host.IsNull() || host.IsUnknown() || strings.TrimRight(host.ValueString(), "/") == p.identityHost() {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Invalid import identity",
+			fmt.Sprintf("The identity is for the Metabase instance at %q, but the provider is configured to use %q.", host.ValueString(), p.identityHost()),
+		),
+	}
+}
+
+// resolveImportId returns the ID of the resource being imported, either by parsing the import ID or extracting it
+// from the identity.
+func (p *MetabaseProvider) resolveImportId(ctx context.Context, req resource.ImportStateRequest, expected string) (int64, diag.Diagnostics) {
+	if !isIdentityImport(req) {
+		return utils.ParseImportId(req.ID, expected)
+	}
+
+	var identity ResourceIdentityModel
+	diags := req.Identity.Get(ctx, &identity)
+	if diags.HasError() {
+		return 0, diags
+	}
+
+	diags.Append(p.checkIdentityHost(identity.Host)...)
+	return identity.Id.ValueInt64(), diags
+}
+
+// isIdentityImport returns whether the resource is being imported using an import block's identity attribute, rather
+// than an import ID.
+func isIdentityImport(req resource.ImportStateRequest) bool {
+	return req.ID == "" && req.Identity != nil && !req.Identity.Raw.IsNull()
+}
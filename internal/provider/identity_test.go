@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-metabase/internal/schema"
+	"testing"
+)
+
+func TestSetUserIdentity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	provider := &MetabaseProvider{host: "http://localhost:3000/"}
+	identitySchema := schema.UserIdentity()
+	newIdentity := func() *tfsdk.ResourceIdentity {
+		return &tfsdk.ResourceIdentity{
+			Schema: identitySchema,
+			Raw:    tftypes.NewValue(identitySchema.Type().TerraformType(ctx), nil),
+		}
+	}
+
+	t.Run("no identity should do nothing", func(t *testing.T) {
+		diags := provider.setUserIdentity(ctx, nil, &UserModel{Id: types.Int64Value(1)})
+
+		assert.Empty(t, diags)
+	})
+
+	t.Run("a new identity should record the user's details and normalised host", func(t *testing.T) {
+		identity := newIdentity()
+
+		diags := provider.setUserIdentity(ctx, identity, &UserModel{
+			Id:    types.Int64Value(1),
+			Email: types.StringValue("user@example.com"),
+		})
+
+		var result UserIdentityModel
+		diags.Append(identity.Get(ctx, &result)...)
+		assert.Empty(t, diags)
+		assert.Equal(t, types.Int64Value(1), result.Id)
+		assert.Equal(t, types.StringValue("http://localhost:3000"), result.Host)
+		assert.Equal(t, types.StringValue("user@example.com"), result.Email)
+	})
+
+	t.Run("an existing identity should keep the original email address", func(t *testing.T) {
+		identity := newIdentity()
+		provider.setUserIdentity(ctx, identity, &UserModel{
+			Id:    types.Int64Value(1),
+			Email: types.StringValue("user@example.com"),
+		})
+
+		diags := provider.setUserIdentity(ctx, identity, &UserModel{
+			Id:    types.Int64Value(1),
+			Email: types.StringValue("changed@example.com"),
+		})
+
+		var result UserIdentityModel
+		diags.Append(identity.Get(ctx, &result)...)
+		assert.Empty(t, diags)
+		assert.Equal(t, types.StringValue("user@example.com"), result.Email)
+	})
+}
+
+func TestCheckIdentityHost(t *testing.T) {
+	t.Parallel()
+
+	provider := &MetabaseProvider{host: "http://localhost:3000"}
+
+	t.Run("a null host should pass", func(t *testing.T) {
+		assert.Empty(t, provider.checkIdentityHost(types.StringNull()))
+	})
+
+	t.Run("a matching host should pass, ignoring trailing slashes", func(t *testing.T) {
+		assert.Empty(t, provider.checkIdentityHost(types.StringValue("http://localhost:3000/")))
+	})
+
+	t.Run("a different host should return an error", func(t *testing.T) {
+		diags := provider.checkIdentityHost(types.StringValue("https://metabase.example.com"))
+
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Invalid import identity", diags[0].Summary())
+	})
+}
+
+func TestResolveImportId(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	provider := &MetabaseProvider{host: "http://localhost:3000"}
+	identitySchema := schema.ResourceIdentity("table")
+	buildIdentity := func(id int64, host string) *tfsdk.ResourceIdentity {
+		return &tfsdk.ResourceIdentity{
+			Schema: identitySchema,
+			Raw: tftypes.NewValue(identitySchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.Number, id),
+				"host": tftypes.NewValue(tftypes.String, host),
+			}),
+		}
+	}
+
+	t.Run("an import ID should be parsed", func(t *testing.T) {
+		id, diags := provider.resolveImportId(ctx, resource.ImportStateRequest{ID: "12"}, "the ID")
+
+		assert.Empty(t, diags)
+		assert.Equal(t, int64(12), id)
+	})
+
+	t.Run("a malformed import ID should return an error", func(t *testing.T) {
+		_, diags := provider.resolveImportId(ctx, resource.ImportStateRequest{ID: "twelve"}, "the ID")
+
+		assert.True(t, diags.HasError())
+	})
+
+	t.Run("an identity should return its ID", func(t *testing.T) {
+		id, diags := provider.resolveImportId(ctx, resource.ImportStateRequest{
+			Identity: buildIdentity(12, "http://localhost:3000"),
+		}, "the ID")
+
+		assert.Empty(t, diags)
+		assert.Equal(t, int64(12), id)
+	})
+
+	t.Run("an identity for another instance should return an error", func(t *testing.T) {
+		_, diags := provider.resolveImportId(ctx, resource.ImportStateRequest{
+			Identity: buildIdentity(12, "https://metabase.example.com"),
+		}, "the ID")
+
+		assert.True(t, diags.HasError())
+	})
+}
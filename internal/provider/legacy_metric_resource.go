@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"terraform-provider-metabase/internal/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &LegacyMetricResource{}
+var _ resource.ResourceWithIdentity = &LegacyMetricResource{}
+var _ resource.ResourceWithImportState = &LegacyMetricResource{}
+var _ resource.ResourceWithModifyPlan = &LegacyMetricResource{}
+
+type LegacyMetricResource struct {
+	tableDefinitionResource
+}
+
+func (r *LegacyMetricResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_legacy_metric"
+}
+
+func (r *LegacyMetricResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.LegacyMetricResource()
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"iter"
+)
+
+// streamListResults converts the items into a stream of list results, stopping once Terraform's limit is reached or it
+// stops accepting results. The build function should populate the identity and display name of the result, and also
+// the resource if requested.
+func streamListResults[T any](ctx context.Context, req list.ListRequest, items []T, build func(T, *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			build(item, &result)
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// setListResult records the identity and, if requested, the state of a listed resource with the given ID.
+func (p *MetabaseProvider) setListResult(ctx context.Context, req list.ListRequest, result *list.ListResult, id int64, state any) {
+	result.Diagnostics.Append(p.setResourceIdentity(ctx, result.Identity, id)...)
+	if req.IncludeResource {
+		result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+	}
+}
+
+// listError returns a stream containing a single error, which is used when the resources could not be listed.
+func listError(summary string, err error) iter.Seq[list.ListResult] {
+	return list.ListResultsStreamDiagnostics(diag.Diagnostics{
+		diag.NewErrorDiagnostic(summary, fmt.Sprintf("An error occurred: %s", err.Error())),
+	})
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"github.com/bnjns/metabase-sdk-go/service/database"
+	"github.com/bnjns/metabase-sdk-go/service/permissions"
+	"github.com/bnjns/metabase-sdk-go/service/user"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"testing"
+)
+
+func TestStreamListResults(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	provider := &MetabaseProvider{host: "http://localhost:3000"}
+	tables := []client.Table{
+		{Id: 1, DbId: 1, Name: "orders", DisplayName: "Orders", Active: true},
+		{Id: 2, DbId: 1, Name: "people", DisplayName: "People", Active: true},
+	}
+	buildRequest := func(limit int64) list.ListRequest {
+		return list.ListRequest{
+			IncludeResource:        true,
+			Limit:                  limit,
+			ResourceSchema:         schema.TableResource(),
+			ResourceIdentitySchema: schema.ResourceIdentity("table"),
+		}
+	}
+	collect := func(req list.ListRequest) []list.ListResult {
+		results := make([]list.ListResult, 0)
+		stream := streamListResults(ctx, req, tables, func(table client.Table, result *list.ListResult) {
+			var state TableModel
+			mapTableToState(&table, &state)
+
+			result.DisplayName = table.Name
+			provider.setListResult(ctx, req, result, table.Id, state)
+		})
+		for result := range stream {
+			results = append(results, result)
+		}
+		return results
+	}
+
+	t.Run("all items should be included without a limit", func(t *testing.T) {
+		results := collect(buildRequest(0))
+
+		if assert.Len(t, results, 2) {
+			assert.Empty(t, results[0].Diagnostics)
+			assert.Equal(t, "orders", results[0].DisplayName)
+
+			var identity ResourceIdentityModel
+			results[1].Identity.Get(ctx, &identity)
+			assert.Equal(t, int64(2), identity.Id.ValueInt64())
+			assert.Equal(t, "http://localhost:3000", identity.Host.ValueString())
+
+			var state TableModel
+			results[1].Resource.Get(ctx, &state)
+			assert.Equal(t, "People", state.DisplayName.ValueString())
+		}
+	})
+
+	t.Run("results should stop at the limit", func(t *testing.T) {
+		results := collect(buildRequest(1))
+
+		assert.Len(t, results, 1)
+	})
+}
+
+func TestSetListResult(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	provider := &MetabaseProvider{host: "http://localhost:3000"}
+	buildResult := func(req list.ListRequest) (list.ListRequest, list.ListResult) {
+		req.IncludeResource = true
+		return req, req.NewListResult(ctx)
+	}
+
+	t.Run("database state should match the resource schema", func(t *testing.T) {
+		req, result := buildResult(list.ListRequest{
+			ResourceSchema:         schema.DatabaseResource(),
+			ResourceIdentitySchema: schema.ResourceIdentity("database"),
+		})
+		var state DatabaseModel
+		diags := mapDatabaseToState(ctx, &client.Database{Database: database.Database{Id: 2, Name: "Warehouse", Engine: database.EnginePostgres}}, &state)
+
+		provider.setListResult(ctx, req, &result, 2, state)
+
+		assert.Empty(t, diags)
+		assert.Empty(t, result.Diagnostics)
+	})
+
+	t.Run("permissions group state should match the resource schema", func(t *testing.T) {
+		req, result := buildResult(list.ListRequest{
+			ResourceSchema:         schema.PermissionsGroupResource(),
+			ResourceIdentitySchema: schema.ResourceIdentity("permissions group"),
+		})
+		var state PermissionsGroupModel
+		mapPermissionsGroupToState(&permissions.Group{Id: 3, Name: "Analysts"}, &state)
+
+		provider.setListResult(ctx, req, &result, 3, state)
+
+		assert.Empty(t, result.Diagnostics)
+	})
+
+	t.Run("API key state should match the resource schema", func(t *testing.T) {
+		req, result := buildResult(list.ListRequest{
+			ResourceSchema:         schema.ApiKeyResource(),
+			ResourceIdentitySchema: schema.ResourceIdentity("API key"),
+		})
+		state := ApiKeyModel{
+			Key:              types.StringNull(),
+			RotationTriggers: types.MapNull(types.StringType),
+		}
+		mapApiKeyToState(&client.ApiKey{Id: 4, Name: "CI"}, &state)
+
+		provider.setListResult(ctx, req, &result, 4, state)
+
+		assert.Empty(t, result.Diagnostics)
+	})
+
+	t.Run("user state should match the resource schema", func(t *testing.T) {
+		_, result := buildResult(list.ListRequest{
+			ResourceSchema:         schema.UserResource(),
+			ResourceIdentitySchema: schema.UserIdentity(),
+		})
+		var state UserResourceModel
+		mapUserToState(&user.User{Id: 5, Email: "user@example.com"}, &state.UserModel)
+
+		result.Diagnostics.Append(provider.setUserIdentity(ctx, result.Identity, &state.UserModel)...)
+		result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+
+		assert.Empty(t, result.Diagnostics)
+	})
+}
+
+func TestFilterPermissionsGroups(t *testing.T) {
+	t.Parallel()
+
+	groups := []permissions.Group{
+		{Id: 4, Name: "Marketing"},
+		{Id: permissions.GroupAdministrators, Name: "Administrators"},
+		{Id: 3, Name: "Analysts"},
+		{Id: permissions.GroupAllUsers, Name: "All Users"},
+	}
+
+	t.Run("built-in groups should be excluded", func(t *testing.T) {
+		filtered := filterPermissionsGroups(groups, &PermissionsGroupListResourceModel{NamePrefix: types.StringNull()})
+
+		if assert.Len(t, filtered, 2) {
+			assert.Equal(t, int64(3), filtered[0].Id)
+			assert.Equal(t, int64(4), filtered[1].Id)
+		}
+	})
+
+	t.Run("groups should be filtered by name prefix", func(t *testing.T) {
+		filtered := filterPermissionsGroups(groups, &PermissionsGroupListResourceModel{NamePrefix: types.StringValue("Ana")})
+
+		if assert.Len(t, filtered, 1) {
+			assert.Equal(t, "Analysts", filtered[0].Name)
+		}
+	})
+}
+
+func TestFilterDatabases(t *testing.T) {
+	t.Parallel()
+
+	databases := []client.Database{
+		{Database: database.Database{Id: 13, Name: "Internal Metabase Database", Engine: database.EnginePostgres}, IsAudit: true},
+		{Database: database.Database{Id: 3, Name: "Events", Engine: "mysql"}},
+		{Database: database.Database{Id: 2, Name: "Warehouse", Engine: database.EnginePostgres}},
+		{Database: database.Database{Id: 1, Name: "Sample Database", Engine: "h2", IsSample: true}},
+	}
+
+	t.Run("the audit and sample databases should be excluded by default", func(t *testing.T) {
+		filtered := filterDatabases(databases, &DatabaseListResourceModel{})
+
+		if assert.Len(t, filtered, 2) {
+			assert.Equal(t, int64(2), filtered[0].Id)
+			assert.Equal(t, int64(3), filtered[1].Id)
+		}
+	})
+
+	t.Run("the sample database should be included if requested", func(t *testing.T) {
+		filtered := filterDatabases(databases, &DatabaseListResourceModel{IncludeSample: types.BoolValue(true)})
+
+		if assert.Len(t, filtered, 3) {
+			assert.Equal(t, int64(1), filtered[0].Id)
+		}
+	})
+
+	t.Run("databases should be filtered by engine", func(t *testing.T) {
+		filtered := filterDatabases(databases, &DatabaseListResourceModel{Engine: types.StringValue("postgres")})
+
+		if assert.Len(t, filtered, 1) {
+			assert.Equal(t, "Warehouse", filtered[0].Name)
+		}
+	})
+}
+
+func TestFilterTables(t *testing.T) {
+	t.Parallel()
+
+	public := "public"
+	analytics := "analytics"
+	tables := []client.Table{
+		{Id: 3, DbId: 2, Schema: &analytics, Name: "events", Active: true},
+		{Id: 2, DbId: 1, Schema: &public, Name: "old_orders", Active: false},
+		{Id: 1, DbId: 1, Schema: &public, Name: "orders", Active: true},
+		{Id: 4, DbId: 1, Schema: &analytics, Name: "sessions", Active: true},
+	}
+
+	t.Run("inactive tables should be excluded", func(t *testing.T) {
+		filtered := filterTables(tables, &TableListResourceModel{})
+
+		if assert.Len(t, filtered, 3) {
+			assert.Equal(t, int64(1), filtered[0].Id)
+		}
+	})
+
+	t.Run("tables should be filtered by database and schema", func(t *testing.T) {
+		filtered := filterTables(tables, &TableListResourceModel{
+			DatabaseId: types.Int64Value(1),
+			Schema:     types.StringValue("analytics"),
+		})
+
+		if assert.Len(t, filtered, 1) {
+			assert.Equal(t, "sessions", filtered[0].Name)
+		}
+	})
+}
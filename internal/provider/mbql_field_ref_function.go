@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &MbqlFieldRefFunction{}
+
+type MbqlFieldRefFunction struct{}
+
+func (f *MbqlFieldRefFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "mbql_field_ref"
+}
+
+func (f *MbqlFieldRefFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = schema.MbqlFieldRefFunction()
+}
+
+func (f *MbqlFieldRefFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var fieldId int64
+	resp.Error = req.Arguments.Get(ctx, &fieldId)
+	if resp.Error != nil {
+		return
+	}
+
+	if fieldId < 1 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The field_id must be a positive integer, got: %d", fieldId))
+		return
+	}
+
+	// The field reference is a tuple rather than a JSON string, so that it can be used directly in template tags and
+	// passed to jsonencode. The options are always null, which Metabase treats the same as an empty object.
+	fieldRef := types.TupleValueMust(
+		[]attr.Type{types.StringType, types.Int64Type, types.StringType},
+		[]attr.Value{types.StringValue("field"), types.Int64Value(fieldId), types.StringNull()},
+	)
+
+	resp.Error = resp.Result.Set(ctx, types.DynamicValue(fieldRef))
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-metabase/internal/transforms"
+	"testing"
+)
+
+func TestMbqlFieldRefFunction(t *testing.T) {
+	t.Parallel()
+
+	run := func(fieldId int64) function.RunResponse {
+		resp := function.RunResponse{Result: function.NewResultData(types.DynamicUnknown())}
+		(&MbqlFieldRefFunction{}).Run(context.Background(), function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.Int64Value(fieldId)}),
+		}, &resp)
+
+		return resp
+	}
+
+	t.Run("the field reference should be created", func(t *testing.T) {
+		resp := run(12)
+
+		assert.Nil(t, resp.Error)
+		expected := types.TupleValueMust(
+			[]attr.Type{types.StringType, types.Int64Type, types.StringType},
+			[]attr.Value{types.StringValue("field"), types.Int64Value(12), types.StringNull()},
+		)
+		assert.Equal(t, types.DynamicValue(expected), resp.Result.Value())
+	})
+
+	t.Run("the field reference should be encoded as JSON", func(t *testing.T) {
+		resp := run(12)
+		fieldRef, err := transforms.FromTerraformDynamic(resp.Result.Value())
+
+		assert.Nil(t, err)
+		assert.Equal(t, []any{"field", int64(12), nil}, fieldRef)
+	})
+
+	t.Run("an invalid field ID should return an error", func(t *testing.T) {
+		resp := run(0)
+
+		assert.NotNil(t, resp.Error)
+		assert.Equal(t, int64(0), *resp.Error.FunctionArgument)
+	})
+}
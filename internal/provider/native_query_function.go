@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/transforms"
+	"terraform-provider-metabase/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &NativeQueryFunction{}
+
+type NativeQueryFunction struct{}
+
+func (f *NativeQueryFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "native_query"
+}
+
+func (f *NativeQueryFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = schema.NativeQueryFunction()
+}
+
+func (f *NativeQueryFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var databaseId int64
+	var sql string
+	var templateTags types.Dynamic
+	resp.Error = req.Arguments.Get(ctx, &databaseId, &sql, &templateTags)
+	if resp.Error != nil {
+		return
+	}
+
+	query, funcErr := buildNativeQuery(databaseId, sql, templateTags)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, query)
+}
+
+// buildNativeQuery creates the normalised JSON for a native dataset query.
+func buildNativeQuery(databaseId int64, sql string, templateTags types.Dynamic) (string, *function.FuncError) {
+	if databaseId < 1 {
+		return "", function.NewArgumentFuncError(0, fmt.Sprintf("The database_id must be a positive integer, got: %d", databaseId))
+	}
+	if sql == "" {
+		return "", function.NewArgumentFuncError(1, "The sql must not be empty")
+	}
+
+	native := map[string]any{
+		"query": sql,
+	}
+
+	tags, err := transforms.FromTerraformDynamic(templateTags)
+	if err != nil {
+		return "", function.NewArgumentFuncError(2, fmt.Sprintf("The template_tags could not be converted: %s", err.Error()))
+	}
+	if tags != nil {
+		if _, ok := tags.(map[string]any); !ok {
+			return "", function.NewArgumentFuncError(2, "The template_tags must be an object or map")
+		}
+		native["template-tags"] = tags
+	}
+
+	query, err := json.Marshal(map[string]any{
+		"database": databaseId,
+		"type":     "native",
+		"native":   native,
+	})
+	if err != nil {
+		return "", function.NewFuncError(fmt.Sprintf("Error creating the query: %s", err.Error()))
+	}
+
+	// Normalise the JSON so that numbers are encoded in the same way as the resources
+	normalized, err := utils.NormalizeJson(string(query))
+	if err != nil {
+		return "", function.NewFuncError(fmt.Sprintf("Error creating the query: %s", err.Error()))
+	}
+
+	return normalized, nil
+}
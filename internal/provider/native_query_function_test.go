@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-metabase/internal/utils"
+	"testing"
+)
+
+func TestBuildNativeQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("a query without template tags should be created", func(t *testing.T) {
+		query, err := buildNativeQuery(1, "SELECT 1", types.DynamicNull())
+
+		assert.Nil(t, err)
+		assert.Equal(t, `{"database":1,"native":{"query":"SELECT 1"},"type":"native"}`, query)
+	})
+
+	t.Run("a query with template tags should be created", func(t *testing.T) {
+		tagType := map[string]attr.Type{
+			"name":         types.StringType,
+			"display-name": types.StringType,
+			"type":         types.StringType,
+			"required":     types.BoolType,
+		}
+		templateTags := types.DynamicValue(types.ObjectValueMust(
+			map[string]attr.Type{"category": types.ObjectType{AttrTypes: tagType}},
+			map[string]attr.Value{
+				"category": types.ObjectValueMust(tagType, map[string]attr.Value{
+					"name":         types.StringValue("category"),
+					"display-name": types.StringValue("Category"),
+					"type":         types.StringValue("text"),
+					"required":     types.BoolValue(false),
+				}),
+			},
+		))
+
+		query, err := buildNativeQuery(2, "SELECT * FROM products WHERE category = {{category}}", templateTags)
+
+		assert.Nil(t, err)
+		assert.Equal(t, `{"database":2,"native":{"query":"SELECT * FROM products WHERE category = {{category}}","template-tags":{"category":{"display-name":"Category","name":"category","required":false,"type":"text"}}},"type":"native"}`, query)
+
+		normalized, _ := utils.NormalizeJson(query)
+		assert.Equal(t, query, normalized)
+	})
+
+	t.Run("a dimension created by mbql_field_ref should be encoded", func(t *testing.T) {
+		resp := function.RunResponse{Result: function.NewResultData(types.DynamicUnknown())}
+		(&MbqlFieldRefFunction{}).Run(context.Background(), function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.Int64Value(12)}),
+		}, &resp)
+		fieldRef := resp.Result.Value().(types.Dynamic).UnderlyingValue()
+
+		tagType := map[string]attr.Type{
+			"type":      types.StringType,
+			"dimension": fieldRef.Type(context.Background()),
+		}
+		templateTags := types.DynamicValue(types.ObjectValueMust(
+			map[string]attr.Type{"category": types.ObjectType{AttrTypes: tagType}},
+			map[string]attr.Value{
+				"category": types.ObjectValueMust(tagType, map[string]attr.Value{
+					"type":      types.StringValue("dimension"),
+					"dimension": fieldRef,
+				}),
+			},
+		))
+
+		query, err := buildNativeQuery(2, "SELECT * FROM products WHERE {{category}}", templateTags)
+
+		assert.Nil(t, err)
+		assert.Equal(t, `{"database":2,"native":{"query":"SELECT * FROM products WHERE {{category}}","template-tags":{"category":{"dimension":["field",12,null],"type":"dimension"}}},"type":"native"}`, query)
+	})
+
+	t.Run("an invalid database ID should return an error", func(t *testing.T) {
+		_, err := buildNativeQuery(0, "SELECT 1", types.DynamicNull())
+
+		assert.NotNil(t, err)
+		assert.Equal(t, int64(0), *err.FunctionArgument)
+	})
+
+	t.Run("an empty query should return an error", func(t *testing.T) {
+		_, err := buildNativeQuery(1, "", types.DynamicNull())
+
+		assert.NotNil(t, err)
+		assert.Equal(t, int64(1), *err.FunctionArgument)
+	})
+
+	t.Run("template tags which aren't an object should return an error", func(t *testing.T) {
+		_, err := buildNativeQuery(1, "SELECT 1", types.DynamicValue(types.StringValue("abc")))
+
+		assert.NotNil(t, err)
+		assert.Equal(t, int64(2), *err.FunctionArgument)
+	})
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+)
+
+var errNativeQuerySnippetNotFound = errors.New("no snippet has the provided name")
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &NativeQuerySnippetDataSource{}
+
+type NativeQuerySnippetDataSource struct {
+	provider *MetabaseProvider
+}
+
+func (s *NativeQuerySnippetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_native_query_snippet"
+}
+
+func (s *NativeQuerySnippetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.NativeQuerySnippetDataSource()
+}
+
+func (s *NativeQuerySnippetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state NativeQuerySnippetModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snippet, err := s.provider.findNativeQuerySnippetByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to find snippet",
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	mapNativeQuerySnippetToState(snippet, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// findNativeQuerySnippetByName fetches the snippet with the given name, ignoring any which are archived. Metabase
+// requires snippet names to be unique, so there can be at most one match.
+func (p *MetabaseProvider) findNativeQuerySnippetByName(ctx context.Context, name string) (*client.NativeQuerySnippet, error) {
+	snippets, err := p.api.NativeQuerySnippet.List(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, snippet := range snippets {
+		if snippet.Name == name {
+			return &snippet, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", errNativeQuerySnippetNotFound, name)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccNativeQuerySnippetDataSource_Name(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_native_query_snippet" "test" {
+	name    = "%s"
+	content = "status = 'active'"
+}
+data "metabase_native_query_snippet" "test" {
+	name = metabase_native_query_snippet.test.name
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.metabase_native_query_snippet.test", "id", "metabase_native_query_snippet.test", "id"),
+					resource.TestCheckResourceAttr("data.metabase_native_query_snippet.test", "content", "status = 'active'"),
+					resource.TestCheckResourceAttr("data.metabase_native_query_snippet.test", "archived", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNativeQuerySnippetDataSource_NameNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "metabase_native_query_snippet" "test" {
+	name = "%s"
+}
+`, acctest.RandString(10)),
+				ExpectError: regexp.MustCompile("no snippet has the provided name"),
+			},
+		},
+	})
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/transforms"
+	"terraform-provider-metabase/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &NativeQuerySnippetResource{}
+var _ resource.ResourceWithIdentity = &NativeQuerySnippetResource{}
+var _ resource.ResourceWithImportState = &NativeQuerySnippetResource{}
+
+type NativeQuerySnippetResource struct {
+	provider *MetabaseProvider
+}
+
+type NativeQuerySnippetModel struct {
+	Id           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Content      types.String `tfsdk:"content"`
+	CollectionId types.Int64  `tfsdk:"collection_id"`
+	Archived     types.Bool   `tfsdk:"archived"`
+	CreatorId    types.Int64  `tfsdk:"creator_id"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+func (s *NativeQuerySnippetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_native_query_snippet"
+}
+
+func (s *NativeQuerySnippetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.NativeQuerySnippetResource()
+}
+
+func (s *NativeQuerySnippetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schema.ResourceIdentity("snippet")
+}
+
+func (s *NativeQuerySnippetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NativeQuerySnippetModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Snippets can only be archived once they've been created
+	request := buildNativeQuerySnippetRequest(plan)
+	request.Archived = nil
+	snippet, err := s.provider.api.NativeQuerySnippet.Create(ctx, request)
+	if err == nil && plan.Archived.ValueBool() {
+		snippet, err = s.provider.api.NativeQuerySnippet.Update(ctx, snippet.Id, buildNativeQuerySnippetRequest(plan))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating snippet",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	mapNativeQuerySnippetToState(snippet, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(s.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (s *NativeQuerySnippetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NativeQuerySnippetModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snippetId := state.Id.ValueInt64()
+	snippet, err := s.provider.api.NativeQuerySnippet.Get(ctx, snippetId)
+	if err != nil {
+		diags = utils.HandleResourceReadError(ctx, "snippet", snippetId, err, resp)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	mapNativeQuerySnippetToState(snippet, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(s.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func (s *NativeQuerySnippetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NativeQuerySnippetModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snippetId := plan.Id.ValueInt64()
+	snippet, err := s.provider.api.NativeQuerySnippet.Update(ctx, snippetId, buildNativeQuerySnippetRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error updating snippet with ID %d", snippetId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	mapNativeQuerySnippetToState(snippet, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(s.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (s *NativeQuerySnippetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NativeQuerySnippetModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snippetId := state.Id.ValueInt64()
+	err := s.provider.api.NativeQuerySnippet.Archive(ctx, snippetId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error archiving snippet with ID %d", snippetId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (s *NativeQuerySnippetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	snippetId, diags := s.provider.resolveImportId(ctx, req, "the numeric ID of the snippet")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snippet, err := s.provider.api.NativeQuerySnippet.Get(ctx, snippetId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing snippet with ID %d", snippetId),
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	var state NativeQuerySnippetModel
+	mapNativeQuerySnippetToState(snippet, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(s.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func buildNativeQuerySnippetRequest(plan NativeQuerySnippetModel) *client.NativeQuerySnippetRequest {
+	return &client.NativeQuerySnippetRequest{
+		Name:         plan.Name.ValueString(),
+		Description:  plan.Description.ValueStringPointer(),
+		Content:      plan.Content.ValueString(),
+		CollectionId: plan.CollectionId.ValueInt64Pointer(),
+		Archived:     plan.Archived.ValueBoolPointer(),
+	}
+}
+
+func mapNativeQuerySnippetToState(snippet *client.NativeQuerySnippet, target *NativeQuerySnippetModel) {
+	target.Id = types.Int64Value(snippet.Id)
+	target.Name = types.StringValue(snippet.Name)
+	target.Description = transforms.ToTerraformString(snippet.Description)
+	target.CollectionId = transforms.ToTerraformInt(snippet.CollectionId)
+	target.Archived = types.BoolValue(snippet.Archived)
+	target.CreatorId = types.Int64Value(snippet.CreatorId)
+	target.CreatedAt = types.StringValue(snippet.CreatedAt)
+
+	// Keep the existing content if it only differs in line endings or trailing whitespace, as Metabase may reformat it
+	if target.Content.IsNull() || target.Content.IsUnknown() || !utils.WhitespaceEquivalent(target.Content.ValueString(), snippet.Content) {
+		target.Content = types.StringValue(snippet.Content)
+	}
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-metabase/internal/client"
+	"testing"
+)
+
+func TestMapNativeQuerySnippetToState(t *testing.T) {
+	t.Parallel()
+
+	snippet := &client.NativeQuerySnippet{
+		Id:      1,
+		Name:    "active_customers",
+		Content: "status = 'active'\nAND deleted_at IS NULL",
+	}
+
+	t.Run("content which only differs in line endings and trailing whitespace should be kept", func(t *testing.T) {
+		state := NativeQuerySnippetModel{
+			Content: types.StringValue("status = 'active'  \r\nAND deleted_at IS NULL\n\n"),
+		}
+
+		mapNativeQuerySnippetToState(snippet, &state)
+
+		assert.Equal(t, "status = 'active'  \r\nAND deleted_at IS NULL\n\n", state.Content.ValueString())
+	})
+
+	t.Run("content which differs in whitespace within a line should be updated", func(t *testing.T) {
+		state := NativeQuerySnippetModel{
+			Content: types.StringValue("status  =  'active'\nAND deleted_at IS NULL"),
+		}
+
+		mapNativeQuerySnippetToState(snippet, &state)
+
+		assert.Equal(t, snippet.Content, state.Content.ValueString())
+	})
+
+	t.Run("changed content should be updated", func(t *testing.T) {
+		state := NativeQuerySnippetModel{
+			Content: types.StringValue("status = 'inactive'"),
+		}
+
+		mapNativeQuerySnippetToState(snippet, &state)
+
+		assert.Equal(t, snippet.Content, state.Content.ValueString())
+	})
+}
+
+func TestAccNativeQuerySnippetResource_Basic(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_native_query_snippet" "test" {
+	name        = "%s"
+	description = "Only active customers"
+	content     = <<-EOT
+		status = 'active'
+		  AND deleted_at IS NULL
+	EOT
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("metabase_native_query_snippet.test", "id"),
+					resource.TestCheckResourceAttr("metabase_native_query_snippet.test", "name", name),
+					resource.TestCheckResourceAttr("metabase_native_query_snippet.test", "description", "Only active customers"),
+					resource.TestCheckResourceAttr("metabase_native_query_snippet.test", "archived", "false"),
+					resource.TestCheckResourceAttrSet("metabase_native_query_snippet.test", "creator_id"),
+				),
+			},
+			{
+				ResourceName:            "metabase_native_query_snippet.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+		},
+	})
+}
+
+func TestAccNativeQuerySnippetResource_Archived(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_native_query_snippet" "test" {
+	name    = "%s"
+	content = "1 = 1"
+}
+`, name),
+				Check: resource.TestCheckResourceAttr("metabase_native_query_snippet.test", "archived", "false"),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_native_query_snippet" "test" {
+	name     = "%s"
+	content  = "1 = 1"
+	archived = true
+}
+`, name),
+				Check: resource.TestCheckResourceAttr("metabase_native_query_snippet.test", "archived", "true"),
+			},
+		},
+	})
+}
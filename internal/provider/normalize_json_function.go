@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &NormalizeJsonFunction{}
+
+type NormalizeJsonFunction struct{}
+
+func (f *NormalizeJsonFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_json"
+}
+
+func (f *NormalizeJsonFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = schema.NormalizeJsonFunction()
+}
+
+func (f *NormalizeJsonFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := utils.NormalizeJson(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The json is not valid: %s", err.Error()))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, normalized)
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNormalizeJsonFunction(t *testing.T) {
+	t.Parallel()
+
+	run := func(value string) function.RunResponse {
+		resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+		(&NormalizeJsonFunction{}).Run(context.Background(), function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(value)}),
+		}, &resp)
+
+		return resp
+	}
+
+	t.Run("the JSON should be normalised", func(t *testing.T) {
+		resp := run(`{"type": "native", "database": 1}`)
+
+		assert.Nil(t, resp.Error)
+		assert.Equal(t, types.StringValue(`{"database":1,"type":"native"}`), resp.Result.Value())
+	})
+
+	t.Run("invalid JSON should return an error", func(t *testing.T) {
+		resp := run("invalid json")
+
+		assert.NotNil(t, resp.Error)
+		assert.Equal(t, int64(0), *resp.Error.FunctionArgument)
+	})
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"golang.org/x/exp/slices"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+)
+
+// NotificationScheduleModel is the schedule shared by dashboard subscriptions and alerts.
+type NotificationScheduleModel struct {
+	Type  types.String `tfsdk:"type"`
+	Hour  types.Int64  `tfsdk:"hour"`
+	Day   types.String `tfsdk:"day"`
+	Frame types.String `tfsdk:"frame"`
+}
+
+type NotificationEmailChannelModel struct {
+	UserIds   types.Set `tfsdk:"user_ids"`
+	Addresses types.Set `tfsdk:"addresses"`
+}
+
+type NotificationSlackChannelModel struct {
+	Channel types.String `tfsdk:"channel"`
+}
+
+// validateNotificationChannels checks that at least one channel is configured, and that emails have a recipient.
+func validateNotificationChannels(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var email, slack types.Object
+	diags.Append(config.GetAttribute(ctx, path.Root("email"), &email)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("slack"), &slack)...)
+	if diags.HasError() || email.IsUnknown() || slack.IsUnknown() {
+		return diags
+	}
+
+	if email.IsNull() && slack.IsNull() {
+		diags.AddError(
+			"Missing notification channel",
+			"At least one of email or slack must be configured.",
+		)
+	}
+
+	if !email.IsNull() {
+		var emailChannel NotificationEmailChannelModel
+		diags.Append(email.As(ctx, &emailChannel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() || emailChannel.UserIds.IsUnknown() || emailChannel.Addresses.IsUnknown() {
+			return diags
+		}
+
+		if len(emailChannel.UserIds.Elements()) == 0 && len(emailChannel.Addresses.Elements()) == 0 {
+			diags.AddAttributeError(
+				path.Root("email"),
+				"Missing email recipients",
+				"At least one user ID or address must be provided when sending by email.",
+			)
+		}
+	}
+
+	return diags
+}
+
+// buildNotificationChannels converts the configured channels into those sent to the API. Each channel is sent on the
+// same schedule.
+func buildNotificationChannels(ctx context.Context, email types.Object, slack types.Object, scheduleValue types.Object) ([]client.PulseChannel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var schedule NotificationScheduleModel
+	diags.Append(scheduleValue.As(ctx, &schedule, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	newChannel := func(channelType string) client.PulseChannel {
+		return client.PulseChannel{
+			ChannelType:   channelType,
+			Enabled:       true,
+			ScheduleType:  schedule.Type.ValueString(),
+			ScheduleHour:  schedule.Hour.ValueInt64Pointer(),
+			ScheduleDay:   schedule.Day.ValueStringPointer(),
+			ScheduleFrame: schedule.Frame.ValueStringPointer(),
+			Recipients:    make([]client.PulseRecipient, 0),
+		}
+	}
+
+	channels := make([]client.PulseChannel, 0, 2)
+	if !email.IsNull() {
+		var emailChannel NotificationEmailChannelModel
+		diags.Append(email.As(ctx, &emailChannel, basetypes.ObjectAsOptions{})...)
+
+		var userIds []int64
+		var addresses []string
+		diags.Append(emailChannel.UserIds.ElementsAs(ctx, &userIds, true)...)
+		diags.Append(emailChannel.Addresses.ElementsAs(ctx, &addresses, true)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		channel := newChannel(client.ChannelTypeEmail)
+		for _, userId := range userIds {
+			channel.Recipients = append(channel.Recipients, client.PulseRecipient{Id: &userId})
+		}
+		for _, address := range addresses {
+			channel.Recipients = append(channel.Recipients, client.PulseRecipient{Email: address})
+		}
+		channels = append(channels, channel)
+	}
+
+	if !slack.IsNull() {
+		var slackChannel NotificationSlackChannelModel
+		diags.Append(slack.As(ctx, &slackChannel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		channel := newChannel(client.ChannelTypeSlack)
+		channel.Details = map[string]any{"channel": slackChannel.Channel.ValueString()}
+		channels = append(channels, channel)
+	}
+
+	return channels, diags
+}
+
+// mapNotificationChannelsToState sets the email, slack and schedule attributes from the enabled channels returned by
+// the API. Empty recipient sets are kept null if they weren't previously configured, so they don't cause a diff.
+func mapNotificationChannelsToState(ctx context.Context, channels []client.PulseChannel, email *types.Object, slack *types.Object, schedule *types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var priorEmail NotificationEmailChannelModel
+	if !email.IsNull() && !email.IsUnknown() {
+		diags.Append(email.As(ctx, &priorEmail, basetypes.ObjectAsOptions{})...)
+	}
+
+	*email = types.ObjectNull(schema.NotificationEmailChannelType.AttributeTypes())
+	*slack = types.ObjectNull(schema.NotificationSlackChannelType.AttributeTypes())
+	*schedule = types.ObjectNull(schema.NotificationScheduleType.AttributeTypes())
+
+	for _, channel := range channels {
+		if !channel.Enabled {
+			continue
+		}
+
+		switch channel.ChannelType {
+		case client.ChannelTypeEmail:
+			userIds := make([]int64, 0)
+			addresses := make([]string, 0)
+			for _, recipient := range channel.Recipients {
+				if recipient.Id != nil {
+					userIds = append(userIds, *recipient.Id)
+				} else if recipient.Email != "" {
+					addresses = append(addresses, recipient.Email)
+				}
+			}
+			slices.Sort(userIds)
+			slices.Sort(addresses)
+
+			userIdsValue, userIdsDiags := buildRecipientSet(ctx, types.Int64Type, userIds, priorEmail.UserIds)
+			addressesValue, addressesDiags := buildRecipientSet(ctx, types.StringType, addresses, priorEmail.Addresses)
+			diags.Append(userIdsDiags...)
+			diags.Append(addressesDiags...)
+
+			emailValue, emailDiags := types.ObjectValue(schema.NotificationEmailChannelType.AttributeTypes(), map[string]attr.Value{
+				"user_ids":  userIdsValue,
+				"addresses": addressesValue,
+			})
+			diags.Append(emailDiags...)
+			*email = emailValue
+		case client.ChannelTypeSlack:
+			channelName, _ := channel.Details["channel"].(string)
+			slackValue, slackDiags := types.ObjectValue(schema.NotificationSlackChannelType.AttributeTypes(), map[string]attr.Value{
+				"channel": types.StringValue(channelName),
+			})
+			diags.Append(slackDiags...)
+			*slack = slackValue
+		default:
+			continue
+		}
+
+		// All channels are sent on the same schedule, so the first is used
+		if schedule.IsNull() {
+			scheduleValue, scheduleDiags := types.ObjectValue(schema.NotificationScheduleType.AttributeTypes(), map[string]attr.Value{
+				"type":  types.StringValue(channel.ScheduleType),
+				"hour":  types.Int64PointerValue(channel.ScheduleHour),
+				"day":   types.StringPointerValue(channel.ScheduleDay),
+				"frame": types.StringPointerValue(channel.ScheduleFrame),
+			})
+			diags.Append(scheduleDiags...)
+			*schedule = scheduleValue
+		}
+	}
+
+	return diags
+}
+
+func buildRecipientSet[T any](ctx context.Context, elementType attr.Type, values []T, prior types.Set) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 && (prior.IsNull() || prior.IsUnknown()) {
+		return types.SetNull(elementType), nil
+	}
+
+	return types.SetValueFrom(ctx, elementType, values)
+}
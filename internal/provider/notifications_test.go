@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"testing"
+)
+
+func TestBuildNotificationChannels(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schedule := types.ObjectValueMust(schema.NotificationScheduleType.AttributeTypes(), map[string]attr.Value{
+		"type":  types.StringValue("weekly"),
+		"hour":  types.Int64Value(9),
+		"day":   types.StringValue("mon"),
+		"frame": types.StringNull(),
+	})
+
+	t.Run("each channel should use the schedule", func(t *testing.T) {
+		id2 := int64(2)
+		email := types.ObjectValueMust(schema.NotificationEmailChannelType.AttributeTypes(), map[string]attr.Value{
+			"user_ids":  types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(2)}),
+			"addresses": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("someone@example.com")}),
+		})
+		slack := types.ObjectValueMust(schema.NotificationSlackChannelType.AttributeTypes(), map[string]attr.Value{
+			"channel": types.StringValue("#general"),
+		})
+
+		channels, diags := buildNotificationChannels(ctx, email, slack, schedule)
+
+		assert.Empty(t, diags)
+		assert.Len(t, channels, 2)
+		assert.Equal(t, client.ChannelTypeEmail, channels[0].ChannelType)
+		assert.Equal(t, []client.PulseRecipient{{Id: &id2}, {Email: "someone@example.com"}}, channels[0].Recipients)
+		assert.Equal(t, client.ChannelTypeSlack, channels[1].ChannelType)
+		assert.Equal(t, "#general", channels[1].Details["channel"])
+		for _, channel := range channels {
+			assert.True(t, channel.Enabled)
+			assert.Equal(t, "weekly", channel.ScheduleType)
+			assert.Equal(t, int64(9), *channel.ScheduleHour)
+			assert.Equal(t, "mon", *channel.ScheduleDay)
+			assert.Nil(t, channel.ScheduleFrame)
+		}
+	})
+
+	t.Run("unconfigured channels should be omitted", func(t *testing.T) {
+		channels, diags := buildNotificationChannels(
+			ctx,
+			types.ObjectNull(schema.NotificationEmailChannelType.AttributeTypes()),
+			types.ObjectNull(schema.NotificationSlackChannelType.AttributeTypes()),
+			schedule,
+		)
+
+		assert.Empty(t, diags)
+		assert.Empty(t, channels)
+	})
+}
+
+func TestMapNotificationChannelsToState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	hour := int64(8)
+	id1, id3 := int64(1), int64(3)
+
+	t.Run("enabled channels should be mapped", func(t *testing.T) {
+		email := types.ObjectNull(schema.NotificationEmailChannelType.AttributeTypes())
+		slack := types.ObjectNull(schema.NotificationSlackChannelType.AttributeTypes())
+		schedule := types.ObjectNull(schema.NotificationScheduleType.AttributeTypes())
+
+		diags := mapNotificationChannelsToState(ctx, []client.PulseChannel{
+			{
+				ChannelType:  client.ChannelTypeEmail,
+				Enabled:      true,
+				ScheduleType: "daily",
+				ScheduleHour: &hour,
+				Recipients:   []client.PulseRecipient{{Id: &id3}, {Id: &id1}},
+			},
+			{
+				ChannelType:  client.ChannelTypeSlack,
+				Enabled:      false,
+				ScheduleType: "hourly",
+				Details:      map[string]any{"channel": "#general"},
+			},
+		}, &email, &slack, &schedule)
+
+		assert.Empty(t, diags)
+		assert.True(t, slack.IsNull())
+
+		var emailModel NotificationEmailChannelModel
+		email.As(ctx, &emailModel, basetypes.ObjectAsOptions{})
+		assert.Equal(t, types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(3)}), emailModel.UserIds)
+		assert.True(t, emailModel.Addresses.IsNull())
+
+		var scheduleModel NotificationScheduleModel
+		schedule.As(ctx, &scheduleModel, basetypes.ObjectAsOptions{})
+		assert.Equal(t, "daily", scheduleModel.Type.ValueString())
+		assert.Equal(t, hour, scheduleModel.Hour.ValueInt64())
+		assert.True(t, scheduleModel.Day.IsNull())
+	})
+
+	t.Run("a configured empty set of recipients should be retained", func(t *testing.T) {
+		email := types.ObjectValueMust(schema.NotificationEmailChannelType.AttributeTypes(), map[string]attr.Value{
+			"user_ids":  types.SetValueMust(types.Int64Type, []attr.Value{}),
+			"addresses": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("someone@example.com")}),
+		})
+		slack := types.ObjectNull(schema.NotificationSlackChannelType.AttributeTypes())
+		schedule := types.ObjectNull(schema.NotificationScheduleType.AttributeTypes())
+
+		diags := mapNotificationChannelsToState(ctx, []client.PulseChannel{
+			{
+				ChannelType:  client.ChannelTypeEmail,
+				Enabled:      true,
+				ScheduleType: "hourly",
+				Recipients:   []client.PulseRecipient{{Email: "someone@example.com"}},
+			},
+		}, &email, &slack, &schedule)
+
+		assert.Empty(t, diags)
+
+		var emailModel NotificationEmailChannelModel
+		email.As(ctx, &emailModel, basetypes.ObjectAsOptions{})
+		assert.False(t, emailModel.UserIds.IsNull())
+		assert.Empty(t, emailModel.UserIds.Elements())
+	})
+}
@@ -2,12 +2,19 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"github.com/bnjns/metabase-sdk-go/service/permissions"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/validators"
 )
 
+var errPermissionsGroupNotFound = errors.New("no permissions group has the provided name")
+
 // Ensure provider defined types fully satisfy framework interfaces
 var _ datasource.DataSource = &PermissionsGroupDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &PermissionsGroupDataSource{}
 
 type PermissionsGroupDataSource struct {
 	provider *MetabaseProvider
@@ -21,6 +28,12 @@ func (g *PermissionsGroupDataSource) Schema(ctx context.Context, req datasource.
 	resp.Schema = schema.PermissionsGroupDataSource()
 }
 
+func (g *PermissionsGroupDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		validators.ExactlyOneOfValidator("id", "name"),
+	}
+}
+
 func (g *PermissionsGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get the current state
 	var state PermissionsGroupModel
@@ -30,12 +43,42 @@ func (g *PermissionsGroupDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	diags = g.provider.syncPermissionsGroupWithApi(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if state.Name.IsNull() {
+		diags = g.provider.syncPermissionsGroupWithApi(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		group, err := g.provider.findPermissionsGroupByName(ctx, state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to find permissions group",
+				fmt.Sprintf("An error occurred: %s", err.Error()),
+			)
+			return
+		}
+
+		mapPermissionsGroupToState(group, &state)
 	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// findPermissionsGroupByName fetches the permissions group with the given name. Metabase requires group names to be
+// unique, so there can be at most one match.
+func (p *MetabaseProvider) findPermissionsGroupByName(ctx context.Context, name string) (*permissions.Group, error) {
+	groups, err := p.api.Permissions.ListGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		if group.Name == name {
+			return &group, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", errPermissionsGroupNotFound, name)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"regexp"
 	"testing"
 )
 
@@ -30,3 +31,43 @@ data "metabase_permissions_group" "test" {
 		},
 	})
 }
+
+func TestAccPermissionsGroupDataSource_Name(t *testing.T) {
+	groupName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_permissions_group" "test" {
+	name = "%s"
+}
+data "metabase_permissions_group" "test" {
+	name = metabase_permissions_group.test.name
+}
+`, groupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.metabase_permissions_group.test", "id", "metabase_permissions_group.test", "id"),
+					resource.TestCheckResourceAttr("data.metabase_permissions_group.test", "name", groupName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPermissionsGroupDataSource_NameNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "metabase_permissions_group" "test" {
+	name = "%s"
+}
+`, acctest.RandString(10)),
+				ExpectError: regexp.MustCompile("no permissions group has the provided name"),
+			},
+		},
+	})
+}
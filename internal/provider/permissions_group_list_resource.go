@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"github.com/bnjns/metabase-sdk-go/service/permissions"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+	"strings"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ list.ListResource = &PermissionsGroupListResource{}
+
+type PermissionsGroupListResource struct {
+	provider *MetabaseProvider
+}
+
+type PermissionsGroupListResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+}
+
+func (l *PermissionsGroupListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions_group"
+}
+
+func (l *PermissionsGroupListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.PermissionsGroupListResource()
+}
+
+func (l *PermissionsGroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config PermissionsGroupListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	groups, err := l.provider.api.Permissions.ListGroups(ctx)
+	if err != nil {
+		stream.Results = listError("Failed to list permissions groups", err)
+		return
+	}
+
+	groups = filterPermissionsGroups(groups, &config)
+	stream.Results = streamListResults(ctx, req, groups, func(group permissions.Group, result *list.ListResult) {
+		var state PermissionsGroupModel
+		mapPermissionsGroupToState(&group, &state)
+
+		result.DisplayName = group.Name
+		l.provider.setListResult(ctx, req, result, group.Id, state)
+	})
+}
+
+// filterPermissionsGroups removes the built-in groups, which cannot be managed, and any groups not matching the name
+// prefix, and sorts the groups by ID so the results are stable.
+func filterPermissionsGroups(groups []permissions.Group, config *PermissionsGroupListResourceModel) []permissions.Group {
+	filtered := make([]permissions.Group, 0, len(groups))
+	for _, group := range groups {
+		if slices.Contains(validators.ReservedGroupIds, group.Id) {
+			continue
+		}
+		if !config.NamePrefix.IsNull() && !strings.HasPrefix(group.Name, config.NamePrefix.ValueString()) {
+			continue
+		}
+
+		filtered = append(filtered, group)
+	}
+
+	slices.SortFunc(filtered, func(a, b permissions.Group) bool {
+		return a.Id < b.Id
+	})
+
+	return filtered
+}
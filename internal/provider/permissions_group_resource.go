@@ -7,13 +7,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"strconv"
+	"strings"
 	"terraform-provider-metabase/internal/schema"
 	"terraform-provider-metabase/internal/utils"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &PermissionsGroupResource{}
+var _ resource.ResourceWithIdentity = &PermissionsGroupResource{}
 var _ resource.ResourceWithImportState = &PermissionsGroupResource{}
 
 type PermissionsGroupResource struct {
@@ -33,6 +34,10 @@ func (g *PermissionsGroupResource) Schema(ctx context.Context, req resource.Sche
 	resp.Schema = schema.PermissionsGroupResource()
 }
 
+func (g *PermissionsGroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schema.ResourceIdentity("permissions group")
+}
+
 func (g *PermissionsGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan PermissionsGroupModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -67,6 +72,7 @@ func (g *PermissionsGroupResource) Create(ctx context.Context, req resource.Crea
 	// Update the state
 	diags = resp.State.Set(ctx, group)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(g.provider.setResourceIdentity(ctx, resp.Identity, group.Id.ValueInt64())...)
 }
 
 func (g *PermissionsGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -88,6 +94,7 @@ func (g *PermissionsGroupResource) Read(ctx context.Context, req resource.ReadRe
 	mapPermissionsGroupToState(group, &state)
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(g.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
 }
 
 func (g *PermissionsGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -131,6 +138,7 @@ func (g *PermissionsGroupResource) Update(ctx context.Context, req resource.Upda
 	// Update the state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(g.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
 }
 
 func (g *PermissionsGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -153,12 +161,17 @@ func (g *PermissionsGroupResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (g *PermissionsGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	groupId, _ := strconv.ParseInt(req.ID, 10, 64)
+	groupId, diags := g.resolveImportId(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Refresh the state from the API
 	var state PermissionsGroupModel
 	state.Id = types.Int64Value(groupId)
-	diags := g.provider.syncPermissionsGroupWithApi(ctx, &state)
+
+	// Refresh the state from the API
+	diags = g.provider.syncPermissionsGroupWithApi(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -167,6 +180,27 @@ func (g *PermissionsGroupResource) ImportState(ctx context.Context, req resource
 	// Store the state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(g.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+// resolveImportId converts the import ID, which can either be the ID of the group or its name, or the identity into
+// the group ID.
+func (g *PermissionsGroupResource) resolveImportId(ctx context.Context, req resource.ImportStateRequest) (int64, diag.Diagnostics) {
+	if isIdentityImport(req) || utils.IsNumericImportId(req.ID) || strings.TrimSpace(req.ID) == "" {
+		return g.provider.resolveImportId(ctx, req, "the numeric ID or name of the permissions group")
+	}
+
+	group, err := g.provider.findPermissionsGroupByName(ctx, req.ID)
+	if err != nil {
+		return 0, diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				fmt.Sprintf("Error importing permissions group %q", req.ID),
+				fmt.Sprintf("An error occurred: %s", err.Error()),
+			),
+		}
+	}
+
+	return group.Id, nil
 }
 
 func mapPermissionsGroupToState(group *permissions.Group, target *PermissionsGroupModel) {
@@ -29,6 +29,12 @@ resource "metabase_permissions_group" "test" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "metabase_permissions_group.test",
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &PermissionsGroupsDataSource{}
+
+type PermissionsGroupsDataSource struct {
+	provider *MetabaseProvider
+}
+
+type PermissionsGroupsModel struct {
+	AllUsersGroupId       types.Int64                  `tfsdk:"all_users_group_id"`
+	AdministratorsGroupId types.Int64                  `tfsdk:"administrators_group_id"`
+	Groups                []PermissionsGroupsItemModel `tfsdk:"groups"`
+}
+
+type PermissionsGroupsItemModel struct {
+	Id          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	MemberCount types.Int64  `tfsdk:"member_count"`
+}
+
+func (g *PermissionsGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions_groups"
+}
+
+func (g *PermissionsGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.PermissionsGroupsDataSource()
+}
+
+func (g *PermissionsGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	groups, err := g.provider.api.Permissions.ListGroupsWithType(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list permissions groups",
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	var state PermissionsGroupsModel
+	mapPermissionsGroupsToState(groups, &state)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// The names Metabase gives its built-in groups, used to identify them on versions that don't return a magic group type.
+const (
+	builtInAllUsersGroupName       = "All Users"
+	builtInAdministratorsGroupName = "Administrators"
+)
+
+func mapPermissionsGroupsToState(groups []client.PermissionsGroup, target *PermissionsGroupsModel) {
+	slices.SortFunc(groups, func(a, b client.PermissionsGroup) bool {
+		return a.Id < b.Id
+	})
+
+	hasMagicGroupTypes := slices.ContainsFunc(groups, func(group client.PermissionsGroup) bool {
+		return group.MagicGroupType != nil
+	})
+
+	target.AllUsersGroupId = types.Int64Null()
+	target.AdministratorsGroupId = types.Int64Null()
+	target.Groups = make([]PermissionsGroupsItemModel, len(groups))
+	for i, group := range groups {
+		target.Groups[i] = PermissionsGroupsItemModel{
+			Id:          types.Int64Value(group.Id),
+			Name:        types.StringValue(group.Name),
+			MemberCount: types.Int64Value(group.MemberCount),
+		}
+
+		switch builtInGroupType(group, hasMagicGroupTypes) {
+		case client.MagicGroupTypeAllUsers:
+			target.AllUsersGroupId = types.Int64Value(group.Id)
+		case client.MagicGroupTypeAdministrators:
+			target.AdministratorsGroupId = types.Int64Value(group.Id)
+		}
+	}
+}
+
+// builtInGroupType returns the magic group type of the group, or an empty string if it isn't a built-in group. Older
+// versions of Metabase don't return the magic group type, so the built-in groups are matched by their name instead.
+func builtInGroupType(group client.PermissionsGroup, hasMagicGroupTypes bool) string {
+	if hasMagicGroupTypes {
+		if group.MagicGroupType == nil {
+			return ""
+		}
+		return *group.MagicGroupType
+	}
+
+	switch group.Name {
+	case builtInAllUsersGroupName:
+		return client.MagicGroupTypeAllUsers
+	case builtInAdministratorsGroupName:
+		return client.MagicGroupTypeAdministrators
+	default:
+		return ""
+	}
+}
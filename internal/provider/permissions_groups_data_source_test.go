@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/bnjns/metabase-sdk-go/service/permissions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-metabase/internal/client"
+	"testing"
+)
+
+func TestMapPermissionsGroupsToState(t *testing.T) {
+	t.Parallel()
+
+	group := func(id int64, name string, memberCount int64, magicGroupType *string) client.PermissionsGroup {
+		return client.PermissionsGroup{
+			Group:          permissions.Group{Id: id, Name: name, MemberCount: memberCount},
+			MagicGroupType: magicGroupType,
+		}
+	}
+	allUsers := client.MagicGroupTypeAllUsers
+	administrators := client.MagicGroupTypeAdministrators
+
+	t.Run("groups should be sorted and the built-in groups identified", func(t *testing.T) {
+		groups := []client.PermissionsGroup{
+			group(5, "Analysts", 3, nil),
+			group(2, "Administrators", 1, &administrators),
+			group(1, "All Users", 4, &allUsers),
+		}
+		var state PermissionsGroupsModel
+
+		mapPermissionsGroupsToState(groups, &state)
+
+		assert.Equal(t, int64(1), state.AllUsersGroupId.ValueInt64())
+		assert.Equal(t, int64(2), state.AdministratorsGroupId.ValueInt64())
+		if assert.Len(t, state.Groups, 3) {
+			assert.Equal(t, int64(1), state.Groups[0].Id.ValueInt64())
+			assert.Equal(t, "Analysts", state.Groups[2].Name.ValueString())
+			assert.Equal(t, int64(3), state.Groups[2].MemberCount.ValueInt64())
+		}
+	})
+
+	t.Run("the built-in groups should be identified by their magic group type when their names are localised", func(t *testing.T) {
+		groups := []client.PermissionsGroup{
+			group(3, "Alle Benutzer", 0, &allUsers),
+			group(4, "Administratoren", 0, &administrators),
+			group(5, "Administrators", 0, nil),
+		}
+		var state PermissionsGroupsModel
+
+		mapPermissionsGroupsToState(groups, &state)
+
+		assert.Equal(t, int64(3), state.AllUsersGroupId.ValueInt64())
+		assert.Equal(t, int64(4), state.AdministratorsGroupId.ValueInt64())
+	})
+
+	t.Run("the built-in groups should be identified by name when there are no magic group types", func(t *testing.T) {
+		groups := []client.PermissionsGroup{
+			group(3, "All Users", 0, nil),
+			group(4, "Administrators", 0, nil),
+			group(5, "Analysts", 0, nil),
+		}
+		var state PermissionsGroupsModel
+
+		mapPermissionsGroupsToState(groups, &state)
+
+		assert.Equal(t, int64(3), state.AllUsersGroupId.ValueInt64())
+		assert.Equal(t, int64(4), state.AdministratorsGroupId.ValueInt64())
+	})
+
+	t.Run("missing built-in groups should be null", func(t *testing.T) {
+		groups := []client.PermissionsGroup{
+			group(5, "Analysts", 0, nil),
+		}
+		var state PermissionsGroupsModel
+
+		mapPermissionsGroupsToState(groups, &state)
+
+		assert.True(t, state.AllUsersGroupId.IsNull())
+		assert.True(t, state.AdministratorsGroupId.IsNull())
+	})
+}
+
+func TestAccPermissionsGroupsDataSource_Basic(t *testing.T) {
+	groupName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_permissions_group" "test" {
+	name = "%s"
+}
+data "metabase_permissions_groups" "test" {
+	depends_on = [metabase_permissions_group.test]
+}
+`, groupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.metabase_permissions_groups.test", "all_users_group_id", "1"),
+					resource.TestCheckResourceAttr("data.metabase_permissions_groups.test", "administrators_group_id", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.metabase_permissions_groups.test", "groups.*", map[string]string{
+						"name":         groupName,
+						"member_count": "0",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -5,28 +5,41 @@ import (
 	"fmt"
 	"github.com/bnjns/metabase-sdk-go/metabase"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
 	"terraform-provider-metabase/internal/utils"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ provider.Provider = &MetabaseProvider{}
+var _ provider.ProviderWithEphemeralResources = &MetabaseProvider{}
+var _ provider.ProviderWithFunctions = &MetabaseProvider{}
+var _ provider.ProviderWithListResources = &MetabaseProvider{}
 
 type MetabaseProvider struct {
 	client     *metabase.Client
+	api        *client.Client
 	configured bool
 	version    string
+
+	host     string
+	username string
+	password string
 }
 
 type MetabaseProviderModel struct {
-	Host     types.String `tfsdk:"host"`
-	ApiKey   types.String `tfsdk:"api_key"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	Headers  types.Map    `tfsdk:"headers"`
+	Host         types.String `tfsdk:"host"`
+	ApiKey       types.String `tfsdk:"api_key"`
+	SessionToken types.String `tfsdk:"session_token"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	Headers      types.Map    `tfsdk:"headers"`
 }
 
 func (p *MetabaseProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -59,7 +72,7 @@ func (p *MetabaseProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	metabaseAuth, err := createAuth(config)
+	metabaseAuth, err := CreateAuth(config)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create client",
@@ -67,7 +80,7 @@ func (p *MetabaseProvider) Configure(ctx context.Context, req provider.Configure
 		)
 		return
 	}
-	client, err := metabase.NewClient(host, metabaseAuth, metabase.WithHeaders(headers))
+	sdkClient, err := metabase.NewClient(host, metabaseAuth, metabase.WithHeaders(headers))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create client",
@@ -76,18 +89,67 @@ func (p *MetabaseProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	p.client = client
+	p.client = sdkClient
+	p.api = client.New(host, metabaseAuth, headers)
+	p.host = host
+	p.username = utils.GetConfigValue(config.Username, "METABASE_USERNAME")
+	p.password = utils.GetConfigValue(config.Password, "METABASE_PASSWORD")
 	p.configured = true
 }
 
 func (p *MetabaseProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		func() resource.Resource {
+			return &ActionResource{provider: p}
+		},
+		func() resource.Resource {
+			return &AlertResource{provider: p}
+		},
+		func() resource.Resource {
+			return &ApiKeyResource{provider: p}
+		},
+		func() resource.Resource {
+			return &DashboardSubscriptionResource{provider: p}
+		},
 		func() resource.Resource {
 			return &DatabaseResource{provider: p}
 		},
+		func() resource.Resource {
+			return &EmbeddingResource{provider: p}
+		},
+		func() resource.Resource {
+			return &EmbeddingSettingsResource{provider: p}
+		},
+		func() resource.Resource {
+			return &FieldResource{provider: p}
+		},
+		func() resource.Resource {
+			return &LegacyMetricResource{tableDefinitionResource{provider: p, noun: tableDefinitionLegacyMetric}}
+		},
+		func() resource.Resource {
+			return &NativeQuerySnippetResource{provider: p}
+		},
 		func() resource.Resource {
 			return &PermissionsGroupResource{provider: p}
 		},
+		func() resource.Resource {
+			return &PublicLinkResource{provider: p}
+		},
+		func() resource.Resource {
+			return &SegmentResource{tableDefinitionResource{provider: p, noun: tableDefinitionSegment}}
+		},
+		func() resource.Resource {
+			return &SlackSettingsResource{provider: p}
+		},
+		func() resource.Resource {
+			return &TableResource{provider: p}
+		},
+		func() resource.Resource {
+			return &TimelineResource{provider: p}
+		},
+		func() resource.Resource {
+			return &TimelineEventResource{provider: p}
+		},
 		func() resource.Resource {
 			return &UserResource{provider: p}
 		},
@@ -102,12 +164,75 @@ func (p *MetabaseProvider) DataSources(ctx context.Context) []func() datasource.
 		func() datasource.DataSource {
 			return &DatabaseDataSource{provider: p}
 		},
+		func() datasource.DataSource {
+			return &DatabaseMetadataDataSource{provider: p}
+		},
+		func() datasource.DataSource {
+			return &DatabasesDataSource{provider: p}
+		},
+		func() datasource.DataSource {
+			return &NativeQuerySnippetDataSource{provider: p}
+		},
 		func() datasource.DataSource {
 			return &PermissionsGroupDataSource{provider: p}
 		},
+		func() datasource.DataSource {
+			return &PermissionsGroupsDataSource{provider: p}
+		},
 		func() datasource.DataSource {
 			return &UserDataSource{provider: p}
 		},
+		func() datasource.DataSource {
+			return &UsersDataSource{provider: p}
+		},
+	}
+}
+
+func (p *MetabaseProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		func() ephemeral.EphemeralResource {
+			return &SessionEphemeralResource{provider: p}
+		},
+	}
+}
+
+func (p *MetabaseProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		func() function.Function {
+			return &MbqlFieldRefFunction{}
+		},
+		func() function.Function {
+			return &NativeQueryFunction{}
+		},
+		func() function.Function {
+			return &NormalizeJsonFunction{}
+		},
+		func() function.Function {
+			return &SignedEmbedUrlFunction{}
+		},
+	}
+}
+
+func (p *MetabaseProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		func() list.ListResource {
+			return &ApiKeyListResource{provider: p}
+		},
+		func() list.ListResource {
+			return &DatabaseListResource{provider: p}
+		},
+		func() list.ListResource {
+			return &FieldListResource{provider: p}
+		},
+		func() list.ListResource {
+			return &PermissionsGroupListResource{provider: p}
+		},
+		func() list.ListResource {
+			return &TableListResource{provider: p}
+		},
+		func() list.ListResource {
+			return &UserListResource{provider: p}
+		},
 	}
 }
 
@@ -123,6 +248,11 @@ func (p *MetabaseProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"session_token": schema.StringAttribute{
+				Description: "An existing session token to use for authenticating with Metabase, such as one created by the metabase_session ephemeral resource. Can also be set with the METABASE_SESSION_TOKEN environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
 			"username": schema.StringAttribute{
 				Description: "The username of the super user to use when interacting with Metabase. Can also be set with the METABASE_USERNAME environment variable.",
 				Optional:    true,
@@ -150,16 +280,21 @@ func New(version string) func() provider.Provider {
 	}
 }
 
-func createAuth(config MetabaseProviderModel) (metabase.Authenticator, error) {
+// CreateAuth creates the authenticator for the provider config, falling back to the METABASE_* environment variables
+// for any values which aren't configured.
+func CreateAuth(config MetabaseProviderModel) (metabase.Authenticator, error) {
 	apiKey := utils.GetConfigValue(config.ApiKey, "METABASE_API_KEY")
+	sessionToken := utils.GetConfigValue(config.SessionToken, "METABASE_SESSION_TOKEN")
 	username := utils.GetConfigValue(config.Username, "METABASE_USERNAME")
 	password := utils.GetConfigValue(config.Password, "METABASE_PASSWORD")
 
 	if apiKey != "" {
 		return metabase.NewApiKeyAuthenticator(apiKey)
+	} else if sessionToken != "" {
+		return client.NewSessionTokenAuthenticator(sessionToken)
 	} else if username != "" && password != "" {
 		return metabase.NewSessionAuthenticator(username, password)
 	} else {
-		return nil, fmt.Errorf("you must set either the API key (via the api_key attribute or METABASE_API_KEY environment variable) or username and password (via the username and password attributes, or METABASE_USERNAME and METABASE_PASSWORD environment variables)")
+		return nil, fmt.Errorf("you must set either the API key (via the api_key attribute or METABASE_API_KEY environment variable), a session token (via the session_token attribute or METABASE_SESSION_TOKEN environment variable) or username and password (via the username and password attributes, or METABASE_USERNAME and METABASE_PASSWORD environment variables)")
 	}
 }
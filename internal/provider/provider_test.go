@@ -1,19 +1,33 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
 	"testing"
 
+	"github.com/bnjns/metabase-sdk-go/metabase"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"terraform-provider-metabase/internal/client"
 )
 
-const providerConfig = `
+const (
+	testAccHost     = "http://localhost:3000"
+	testAccUsername = "example@example.com"
+	testAccPassword = "password"
+)
+
+var providerConfig = fmt.Sprintf(`
 provider "metabase" {
-	host     = "http://localhost:3000"
-	username = "example@example.com"
-	password = "password"
+	host     = "%s"
+	username = "%s"
+	password = "%s"
 }
-`
+`, testAccHost, testAccUsername, testAccPassword)
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
 // acceptance testing. The factory function will be invoked for every Terraform
@@ -28,3 +42,113 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+// testAccSkipBelowTerraformVersion skips the test if the Terraform CLI used for acceptance testing is older than the
+// given version, for features (such as ephemeral resources) which aren't supported by all the versions we test against.
+func testAccSkipBelowTerraformVersion(t *testing.T, minimum string) {
+	if os.Getenv("TF_ACC") == "" {
+		return
+	}
+
+	terraformPath := os.Getenv("TF_ACC_TERRAFORM_PATH")
+	if terraformPath == "" {
+		terraformPath = "terraform"
+	}
+
+	output, err := exec.Command(terraformPath, "version", "-json").Output()
+	if err != nil {
+		t.Fatalf("unable to determine the Terraform version: %s", err.Error())
+	}
+
+	var tfVersion struct {
+		Version string `json:"terraform_version"`
+	}
+	if err := json.Unmarshal(output, &tfVersion); err != nil {
+		t.Fatalf("unable to parse the Terraform version: %s", err.Error())
+	}
+
+	if version.Must(version.NewVersion(tfVersion.Version)).LessThan(version.Must(version.NewVersion(minimum))) {
+		t.Skipf("Terraform %s does not support this feature, skipping (requires %s or later)", tfVersion.Version, minimum)
+	}
+}
+
+// testAccApiClient creates a client for the Metabase instance used for acceptance testing, so that tests can create the
+// objects which aren't managed by the provider, such as cards and dashboards. The test is skipped if acceptance tests
+// aren't enabled, as there is no instance to connect to.
+func testAccApiClient(t *testing.T) *client.Client {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' set")
+	}
+
+	authenticator, err := metabase.NewSessionAuthenticator(testAccUsername, testAccPassword)
+	if err != nil {
+		t.Fatalf("unable to create the authenticator: %s", err.Error())
+	}
+
+	// The SDK client initialises the authenticator, which is then shared with the provider's client
+	if _, err := metabase.NewClient(testAccHost, authenticator); err != nil {
+		t.Fatalf("unable to create the client: %s", err.Error())
+	}
+
+	return client.New(testAccHost, authenticator, nil)
+}
+
+// testAccCreateCard creates a native question against the sample database, which is deleted when the test finishes.
+func testAccCreateCard(t *testing.T, api *client.Client, name string) int64 {
+	ctx := context.Background()
+
+	var card client.Card
+	err := api.Post(ctx, "/card", map[string]any{
+		"name":                   name,
+		"type":                   "question",
+		"display":                "table",
+		"visualization_settings": map[string]any{},
+		"dataset_query": map[string]any{
+			"database": 1,
+			"type":     "native",
+			"native":   map[string]any{"query": "SELECT 1"},
+		},
+	}, &card)
+	if err != nil {
+		t.Fatalf("unable to create card %s: %s", name, err.Error())
+	}
+
+	t.Cleanup(func() {
+		_ = api.Delete(ctx, fmt.Sprintf("/card/%d", card.Id), nil)
+	})
+
+	return card.Id
+}
+
+// testAccCreateDashboard creates a dashboard containing the card, which is deleted when the test finishes. The IDs of
+// the dashboard and the card's position on the dashboard are returned.
+func testAccCreateDashboard(t *testing.T, api *client.Client, name string, cardId int64) (int64, int64) {
+	ctx := context.Background()
+
+	var dashboard struct {
+		Id        int64 `json:"id"`
+		Dashcards []struct {
+			Id int64 `json:"id"`
+		} `json:"dashcards"`
+	}
+	err := api.Post(ctx, "/dashboard", map[string]any{"name": name}, &dashboard)
+	if err != nil {
+		t.Fatalf("unable to create dashboard %s: %s", name, err.Error())
+	}
+
+	t.Cleanup(func() {
+		_ = api.Delete(ctx, fmt.Sprintf("/dashboard/%d", dashboard.Id), nil)
+	})
+
+	// New cards are given negative IDs, which Metabase replaces when they are added to the dashboard
+	err = api.Put(ctx, fmt.Sprintf("/dashboard/%d", dashboard.Id), map[string]any{
+		"dashcards": []map[string]any{
+			{"id": -1, "card_id": cardId, "row": 0, "col": 0, "size_x": 4, "size_y": 4},
+		},
+	}, &dashboard)
+	if err != nil || len(dashboard.Dashcards) != 1 {
+		t.Fatalf("unable to add card %d to dashboard %s: %v", cardId, name, err)
+	}
+
+	return dashboard.Id, dashboard.Dashcards[0].Id
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/utils"
+	"terraform-provider-metabase/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &PublicLinkResource{}
+var _ resource.ResourceWithConfigValidators = &PublicLinkResource{}
+var _ resource.ResourceWithImportState = &PublicLinkResource{}
+var _ resource.ResourceWithIdentity = &PublicLinkResource{}
+
+type PublicLinkResource struct {
+	provider *MetabaseProvider
+}
+
+type PublicLinkModel struct {
+	Id          types.String `tfsdk:"id"`
+	CardId      types.Int64  `tfsdk:"card_id"`
+	DashboardId types.Int64  `tfsdk:"dashboard_id"`
+	Uuid        types.String `tfsdk:"uuid"`
+	PublicUrl   types.String `tfsdk:"public_url"`
+}
+
+func (l *PublicLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_public_link"
+}
+
+func (l *PublicLinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.PublicLinkResource()
+}
+
+func (l *PublicLinkResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schema.SharedObjectIdentity()
+}
+
+func (l *PublicLinkResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.ExactlyOneOfValidator("card_id", "dashboard_id"),
+	}
+}
+
+func (l *PublicLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PublicLinkModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	object := newSharedObject(plan.CardId, plan.DashboardId)
+	uuid, err := l.provider.sharingApi(object).CreatePublicLink(ctx, object.id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating public link",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	l.mapPublicLinkToState(object, uuid, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(l.provider.setSharedObjectIdentity(ctx, resp.Identity, object)...)
+}
+
+func (l *PublicLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PublicLinkModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The link no longer exists if it has been revoked
+	object := newSharedObject(state.CardId, state.DashboardId)
+	sharing, err := l.provider.getSharing(ctx, object)
+	if err == nil && sharing.PublicUuid == nil {
+		err = client.ErrNotFound
+	}
+	if err != nil {
+		diags = utils.HandleResourceReadError(ctx, "public link for "+object.objectType, object.id, err, resp)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	l.mapPublicLinkToState(object, *sharing.PublicUuid, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(l.provider.setSharedObjectIdentity(ctx, resp.Identity, object)...)
+}
+
+func (l *PublicLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the configurable attributes require the link to be replaced, so there is nothing to update
+	var plan PublicLinkModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(l.provider.setSharedObjectIdentity(ctx, resp.Identity, newSharedObject(plan.CardId, plan.DashboardId))...)
+}
+
+func (l *PublicLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PublicLinkModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	object := newSharedObject(state.CardId, state.DashboardId)
+	err := l.provider.sharingApi(object).DeletePublicLink(ctx, object.id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error deleting public link for %s", object),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (l *PublicLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	object, diags := l.provider.resolveSharedObjectImport(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sharing, err := l.provider.getSharing(ctx, object)
+	if err == nil && sharing.PublicUuid == nil {
+		err = fmt.Errorf("%s does not have a public link", object)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing public link for %s", object),
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	var state PublicLinkModel
+	l.mapPublicLinkToState(object, *sharing.PublicUuid, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(l.provider.setSharedObjectIdentity(ctx, resp.Identity, object)...)
+}
+
+func (l *PublicLinkResource) mapPublicLinkToState(object sharedObject, uuid string, target *PublicLinkModel) {
+	target.Id = types.StringValue(object.String())
+	target.CardId, target.DashboardId = object.attributes()
+	target.Uuid = types.StringValue(uuid)
+	target.PublicUrl = types.StringValue(object.publicUrl(l.provider.identityHost(), uuid))
+}
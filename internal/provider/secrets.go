@@ -0,0 +1,18 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// secretFingerprint identifies a secret setting returned by the API, so that changes made outside of Terraform can be
+// detected without storing the secret in the state. Metabase usually redacts secrets, in which case the redacted value
+// is used, but otherwise it is hashed.
+func secretFingerprint(secret string) string {
+	if isRedactedValue(secret) {
+		return secret
+	}
+
+	hash := sha256.Sum256([]byte(secret))
+	return "sha256:" + hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestSecretFingerprint(t *testing.T) {
+	t.Parallel()
+
+	t.Run("a redacted secret should be used as is", func(t *testing.T) {
+		assert.Equal(t, "**xoxb-1234**", secretFingerprint("**xoxb-1234**"))
+	})
+
+	t.Run("an unredacted secret should be hashed", func(t *testing.T) {
+		fingerprint := secretFingerprint("xoxb-secret")
+
+		assert.NotContains(t, fingerprint, "xoxb-secret")
+		assert.True(t, strings.HasPrefix(fingerprint, "sha256:"))
+		assert.Equal(t, fingerprint, secretFingerprint("xoxb-secret"))
+		assert.NotEqual(t, fingerprint, secretFingerprint("xoxb-other"))
+	})
+}
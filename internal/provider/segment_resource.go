@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"terraform-provider-metabase/internal/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SegmentResource{}
+var _ resource.ResourceWithIdentity = &SegmentResource{}
+var _ resource.ResourceWithImportState = &SegmentResource{}
+var _ resource.ResourceWithModifyPlan = &SegmentResource{}
+
+type SegmentResource struct {
+	tableDefinitionResource
+}
+
+func (r *SegmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_segment"
+}
+
+func (r *SegmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.SegmentResource()
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+)
+
+const sessionTokenPrivateKey = "session_token"
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ ephemeral.EphemeralResource = &SessionEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &SessionEphemeralResource{}
+
+type SessionEphemeralResource struct {
+	provider *MetabaseProvider
+}
+
+type SessionModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	Host     types.String `tfsdk:"host"`
+	Token    types.String `tfsdk:"token"`
+}
+
+func (s *SessionEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session"
+}
+
+func (s *SessionEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.SessionEphemeralResource()
+}
+
+func (s *SessionEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SessionModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := s.provider.username
+	password := s.provider.password
+	if !data.Username.IsNull() || !data.Password.IsNull() {
+		username = data.Username.ValueString()
+		password = data.Password.ValueString()
+	}
+	if username == "" || password == "" {
+		resp.Diagnostics.AddError(
+			"Unable to create session",
+			"You must either provide both the username and password, or configure the provider with a username and password.",
+		)
+		return
+	}
+
+	token, err := s.provider.api.Session.Create(ctx, &client.LoginRequest{
+		Username: username,
+		Password: password,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create session",
+			fmt.Sprintf("An error occurred when logging in: %s", err.Error()),
+		)
+		return
+	}
+
+	// Store the token so we can log out when closing. Private data must be valid JSON.
+	tokenJson, err := json.Marshal(token)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create session",
+			fmt.Sprintf("An error occurred when storing the session token: %s", err.Error()),
+		)
+		return
+	}
+	diags = resp.Private.SetKey(ctx, sessionTokenPrivateKey, tokenJson)
+	resp.Diagnostics.Append(diags...)
+
+	data.Host = types.StringValue(s.provider.host)
+	data.Token = types.StringValue(token)
+
+	diags = resp.Result.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (s *SessionEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	tokenJson, diags := req.Private.GetKey(ctx, sessionTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(tokenJson) == 0 {
+		return
+	}
+
+	var token string
+	if err := json.Unmarshal(tokenJson, &token); err != nil {
+		resp.Diagnostics.AddError(
+			"Error closing session",
+			fmt.Sprintf("Unable to read the session token: %s", err.Error()),
+		)
+		return
+	}
+
+	err := s.provider.api.Session.Delete(ctx, token)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error closing session",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+}
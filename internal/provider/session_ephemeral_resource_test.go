@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccSessionEphemeralResource_Basic(t *testing.T) {
+	testAccSkipBelowTerraformVersion(t, "1.10.0")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+ephemeral "metabase_session" "test" {}
+
+provider "metabase" {
+	alias         = "session"
+	host          = ephemeral.metabase_session.test.host
+	session_token = ephemeral.metabase_session.test.token
+}
+
+data "metabase_current_user" "test" {
+	provider = metabase.session
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.metabase_current_user.test", "email", "example@example.com"),
+			},
+		},
+	})
+}
+
+func TestAccSessionEphemeralResource_ExplicitCredentials(t *testing.T) {
+	testAccSkipBelowTerraformVersion(t, "1.10.0")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+ephemeral "metabase_session" "test" {
+	username = "example@example.com"
+	password = "password"
+}
+
+provider "metabase" {
+	alias         = "session"
+	host          = ephemeral.metabase_session.test.host
+	session_token = ephemeral.metabase_session.test.token
+}
+
+data "metabase_current_user" "test" {
+	provider = metabase.session
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.metabase_current_user.test", "email", "example@example.com"),
+			},
+		},
+	})
+}
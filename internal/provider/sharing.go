@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+	"terraform-provider-metabase/internal/client"
+)
+
+const (
+	sharedObjectCard      = "card"
+	sharedObjectDashboard = "dashboard"
+)
+
+// sharingApi is implemented by the services of the objects which can be shared publicly or embedded.
+type sharingApi interface {
+	GetSharing(ctx context.Context, id int64) (*client.Sharing, error)
+	CreatePublicLink(ctx context.Context, id int64) (string, error)
+	DeletePublicLink(ctx context.Context, id int64) error
+	UpdateEmbedding(ctx context.Context, id int64, request *client.EmbeddingRequest) (*client.Sharing, error)
+}
+
+// sharedObject identifies the card or dashboard being shared.
+type sharedObject struct {
+	objectType string
+	id         int64
+}
+
+// newSharedObject returns the card or dashboard identified by the configured IDs, of which exactly one is set.
+func newSharedObject(cardId types.Int64, dashboardId types.Int64) sharedObject {
+	if !cardId.IsNull() {
+		return sharedObject{objectType: sharedObjectCard, id: cardId.ValueInt64()}
+	}
+
+	return sharedObject{objectType: sharedObjectDashboard, id: dashboardId.ValueInt64()}
+}
+
+// parseSharedObjectId parses the ID of a sharing resource, which is in the format card/<id> or dashboard/<id>.
+func parseSharedObjectId(id string) (sharedObject, diag.Diagnostics) {
+	objectType, objectId, _ := strings.Cut(id, "/")
+	parsedId, err := strconv.ParseInt(objectId, 10, 64)
+	if err != nil || !isValidSharedObject(objectType, parsedId) {
+		return sharedObject{}, diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Invalid import ID",
+				fmt.Sprintf("The import ID must be in the format card/<card_id> or dashboard/<dashboard_id>, got: %q", id),
+			),
+		}
+	}
+
+	return sharedObject{objectType: objectType, id: parsedId}, nil
+}
+
+func isValidSharedObject(objectType string, id int64) bool {
+	return id > 0 && (objectType == sharedObjectCard || objectType == sharedObjectDashboard)
+}
+
+func (o sharedObject) String() string {
+	return fmt.Sprintf("%s/%d", o.objectType, o.id)
+}
+
+// attributes returns the values of the card_id and dashboard_id attributes identifying the object.
+func (o sharedObject) attributes() (types.Int64, types.Int64) {
+	if o.objectType == sharedObjectCard {
+		return types.Int64Value(o.id), types.Int64Null()
+	}
+
+	return types.Int64Null(), types.Int64Value(o.id)
+}
+
+// publicUrl returns the URL of the object's public link with the given UUID.
+func (o sharedObject) publicUrl(host string, uuid string) string {
+	path := "dashboard"
+	if o.objectType == sharedObjectCard {
+		path = "question"
+	}
+
+	return fmt.Sprintf("%s/public/%s/%s", host, path, uuid)
+}
+
+// sharingApi returns the service used to share the object.
+func (p *MetabaseProvider) sharingApi(object sharedObject) sharingApi {
+	if object.objectType == sharedObjectCard {
+		return p.api.Card
+	}
+
+	return p.api.Dashboard
+}
+
+// getSharing fetches how the object is shared, treating archived objects as not found.
+func (p *MetabaseProvider) getSharing(ctx context.Context, object sharedObject) (*client.Sharing, error) {
+	sharing, err := p.sharingApi(object).GetSharing(ctx, object.id)
+	if err == nil && sharing.Archived {
+		err = client.ErrNotFound
+	}
+
+	return sharing, err
+}
+
+type SharedObjectIdentityModel struct {
+	ObjectType types.String `tfsdk:"object_type"`
+	Id         types.Int64  `tfsdk:"id"`
+	Host       types.String `tfsdk:"host"`
+}
+
+// setSharedObjectIdentity records the identity of a sharing resource, which is the object being shared. The identity is
+// only available when Terraform supports resource identity, so this does nothing otherwise.
+func (p *MetabaseProvider) setSharedObjectIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, object sharedObject) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, SharedObjectIdentityModel{
+		ObjectType: types.StringValue(object.objectType),
+		Id:         types.Int64Value(object.id),
+		Host:       types.StringValue(p.identityHost()),
+	})
+}
+
+// resolveSharedObjectImport returns the object shared by the resource being imported, either by parsing the import ID
+// or extracting it from the identity.
+func (p *MetabaseProvider) resolveSharedObjectImport(ctx context.Context, req resource.ImportStateRequest) (sharedObject, diag.Diagnostics) {
+	if !isIdentityImport(req) {
+		return parseSharedObjectId(req.ID)
+	}
+
+	var identity SharedObjectIdentityModel
+	diags := req.Identity.Get(ctx, &identity)
+	if diags.HasError() {
+		return sharedObject{}, diags
+	}
+
+	diags.Append(p.checkIdentityHost(identity.Host)...)
+	if !isValidSharedObject(identity.ObjectType.ValueString(), identity.Id.ValueInt64()) {
+		diags.AddError(
+			"Invalid import identity",
+			fmt.Sprintf("The object_type must be card or dashboard and the id must be positive, got: %q and %d", identity.ObjectType.ValueString(), identity.Id.ValueInt64()),
+		)
+	}
+
+	return sharedObject{objectType: identity.ObjectType.ValueString(), id: identity.Id.ValueInt64()}, diags
+}
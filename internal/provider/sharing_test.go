@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-metabase/internal/schema"
+	"testing"
+)
+
+func TestParseSharedObjectId(t *testing.T) {
+	t.Parallel()
+
+	t.Run("a card ID should be parsed", func(t *testing.T) {
+		object, diags := parseSharedObjectId("card/12")
+
+		assert.Empty(t, diags)
+		assert.Equal(t, sharedObject{objectType: sharedObjectCard, id: 12}, object)
+		assert.Equal(t, "card/12", object.String())
+	})
+
+	t.Run("a dashboard ID should be parsed", func(t *testing.T) {
+		object, diags := parseSharedObjectId("dashboard/3")
+
+		assert.Empty(t, diags)
+		assert.Equal(t, sharedObject{objectType: sharedObjectDashboard, id: 3}, object)
+	})
+
+	for _, id := range []string{"", "12", "card/", "card/abc", "card/0", "question/12"} {
+		t.Run("an invalid ID should return an error: "+id, func(t *testing.T) {
+			_, diags := parseSharedObjectId(id)
+
+			assert.True(t, diags.HasError())
+			assert.Equal(t, "Invalid import ID", diags[0].Summary())
+		})
+	}
+}
+
+func TestSharedObjectPublicUrl(t *testing.T) {
+	t.Parallel()
+
+	host := "https://metabase.example.com"
+	uuid := "0a7f1b8e-6c2d-4f3e-9b1a-5d4c3b2a1f0e"
+
+	assert.Equal(t, host+"/public/question/"+uuid, sharedObject{objectType: sharedObjectCard, id: 1}.publicUrl(host, uuid))
+	assert.Equal(t, host+"/public/dashboard/"+uuid, sharedObject{objectType: sharedObjectDashboard, id: 1}.publicUrl(host, uuid))
+}
+
+func TestResolveSharedObjectImport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	provider := &MetabaseProvider{host: "http://localhost:3000"}
+	identitySchema := schema.SharedObjectIdentity()
+	buildIdentity := func(objectType string, id int64, host string) *tfsdk.ResourceIdentity {
+		return &tfsdk.ResourceIdentity{
+			Schema: identitySchema,
+			Raw: tftypes.NewValue(identitySchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"object_type": tftypes.NewValue(tftypes.String, objectType),
+				"id":          tftypes.NewValue(tftypes.Number, id),
+				"host":        tftypes.NewValue(tftypes.String, host),
+			}),
+		}
+	}
+
+	t.Run("an import ID should be parsed", func(t *testing.T) {
+		object, diags := provider.resolveSharedObjectImport(ctx, resource.ImportStateRequest{ID: "card/12"})
+
+		assert.Empty(t, diags)
+		assert.Equal(t, sharedObject{objectType: sharedObjectCard, id: 12}, object)
+	})
+
+	t.Run("an identity should return the shared object", func(t *testing.T) {
+		object, diags := provider.resolveSharedObjectImport(ctx, resource.ImportStateRequest{
+			Identity: buildIdentity(sharedObjectDashboard, 3, "http://localhost:3000"),
+		})
+
+		assert.Empty(t, diags)
+		assert.Equal(t, sharedObject{objectType: sharedObjectDashboard, id: 3}, object)
+	})
+
+	t.Run("an identity with an invalid object type should return an error", func(t *testing.T) {
+		_, diags := provider.resolveSharedObjectImport(ctx, resource.ImportStateRequest{
+			Identity: buildIdentity("question", 3, "http://localhost:3000"),
+		})
+
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Invalid import identity", diags[0].Summary())
+	})
+
+	t.Run("an identity for another instance should return an error", func(t *testing.T) {
+		_, diags := provider.resolveSharedObjectImport(ctx, resource.ImportStateRequest{
+			Identity: buildIdentity(sharedObjectCard, 12, "https://metabase.example.com"),
+		})
+
+		assert.True(t, diags.HasError())
+	})
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+	"strings"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/transforms"
+	"terraform-provider-metabase/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &SignedEmbedUrlFunction{}
+
+type SignedEmbedUrlFunction struct{}
+
+// embedClaims are the claims of the JWT used to embed a resource, which Metabase verifies using the embedding secret
+// key.
+type embedClaims struct {
+	Resource map[string]int64 `json:"resource"`
+	Params   map[string]any   `json:"params"`
+	Exp      *int64           `json:"exp,omitempty"`
+}
+
+func (f *SignedEmbedUrlFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "signed_embed_url"
+}
+
+func (f *SignedEmbedUrlFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = schema.SignedEmbedUrlFunction()
+}
+
+func (f *SignedEmbedUrlFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType, secret string
+	var id int64
+	var params types.Dynamic
+	var exp types.Int64
+	resp.Error = req.Arguments.Get(ctx, &resourceType, &id, &params, &secret, &exp)
+	if resp.Error != nil {
+		return
+	}
+
+	embedUrl, funcErr := buildSignedEmbedUrl(resourceType, id, params, secret, exp.ValueInt64Pointer())
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, embedUrl)
+}
+
+// buildSignedEmbedUrl creates the URL path used to embed the resource, which contains the JWT signed with the secret.
+func buildSignedEmbedUrl(resourceType string, id int64, params types.Dynamic, secret string, exp *int64) (string, *function.FuncError) {
+	if !slices.Contains(schema.EmbedResourceTypes, resourceType) {
+		return "", function.NewArgumentFuncError(0, fmt.Sprintf("The resource_type must be one of %s, got: %q", strings.Join(schema.EmbedResourceTypes, ", "), resourceType))
+	}
+	if secret == "" {
+		return "", function.NewArgumentFuncError(3, "The secret must not be empty")
+	}
+
+	// Metabase requires the params to be an object, even if there aren't any locked parameters
+	paramValues, err := transforms.FromTerraformDynamic(params)
+	if err != nil {
+		return "", function.NewArgumentFuncError(2, fmt.Sprintf("The params could not be converted: %s", err.Error()))
+	}
+	if paramValues == nil {
+		paramValues = map[string]any{}
+	}
+	paramsMap, ok := paramValues.(map[string]any)
+	if !ok {
+		return "", function.NewArgumentFuncError(2, "The params must be an object or map")
+	}
+
+	token, err := utils.SignJwtHs256(embedClaims{
+		Resource: map[string]int64{resourceType: id},
+		Params:   paramsMap,
+		Exp:      exp,
+	}, secret)
+	if err != nil {
+		return "", function.NewFuncError(fmt.Sprintf("Error signing the JWT: %s", err.Error()))
+	}
+
+	return fmt.Sprintf("/embed/%s/%s#bordered=true&titled=true", resourceType, token), nil
+}
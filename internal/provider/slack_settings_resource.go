@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+)
+
+const slackSettingsId = "slack"
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SlackSettingsResource{}
+var _ resource.ResourceWithModifyPlan = &SlackSettingsResource{}
+
+type SlackSettingsResource struct {
+	provider *MetabaseProvider
+}
+
+type SlackSettingsModel struct {
+	Id                  types.String `tfsdk:"id"`
+	AppToken            types.String `tfsdk:"app_token"`
+	AppTokenVersion     types.String `tfsdk:"app_token_version"`
+	AppTokenFingerprint types.String `tfsdk:"app_token_fingerprint"`
+	BugReportChannel    types.String `tfsdk:"bug_report_channel"`
+	TokenValid          types.Bool   `tfsdk:"token_valid"`
+}
+
+func (s *SlackSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slack_settings"
+}
+
+func (s *SlackSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.SlackSettingsResource()
+}
+
+func (s *SlackSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when creating or destroying
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state SlackSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The token is set again if the version changes or it was changed outside of Terraform, which Read records by
+	// clearing the fingerprint
+	if shouldSetSlackAppToken(plan, state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("app_token_fingerprint"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("token_valid"), types.BoolUnknown())...)
+	}
+}
+
+func (s *SlackSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SlackSettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var appToken types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("app_token"), &appToken)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := s.provider.api.Slack.UpdateSettings(ctx, &client.SlackSettings{
+		AppToken:         appToken.ValueStringPointer(),
+		BugReportChannel: plan.BugReportChannel.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error configuring Slack",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	state, diags := s.readSettings(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (s *SlackSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SlackSettingsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := s.readSettings(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.AppTokenFingerprint.IsNull() && !newState.AppTokenFingerprint.Equal(state.AppTokenFingerprint) {
+		resp.Diagnostics.AddWarning(
+			"Slack app token changed outside of Terraform",
+			"The Slack app token stored in Metabase no longer matches the token set by Terraform, so it will be set again.",
+		)
+		newState.AppTokenFingerprint = types.StringNull()
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (s *SlackSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state SlackSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := &client.SlackSettings{
+		BugReportChannel: plan.BugReportChannel.ValueStringPointer(),
+	}
+	if shouldSetSlackAppToken(plan, state) {
+		var appToken types.String
+		diags := req.Config.GetAttribute(ctx, path.Root("app_token"), &appToken)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		settings.AppToken = appToken.ValueStringPointer()
+	}
+
+	err := s.provider.api.Slack.UpdateSettings(ctx, settings)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Slack settings",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	newState, diags := s.readSettings(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (s *SlackSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	err := s.provider.api.Slack.Disconnect(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error disconnecting Slack",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+}
+
+// readSettings fetches the current settings from Metabase, retaining the version and any unchanged values from the
+// given model.
+func (s *SlackSettingsResource) readSettings(ctx context.Context, current SlackSettingsModel) (SlackSettingsModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var appToken, bugReportChannel *string
+	var tokenValid *bool
+	for key, value := range map[string]any{
+		client.SettingSlackAppToken:         &appToken,
+		client.SettingSlackBugReportChannel: &bugReportChannel,
+		client.SettingSlackTokenValid:       &tokenValid,
+	} {
+		if err := s.provider.api.Setting.Get(ctx, key, value); err != nil {
+			diags.AddError(
+				"Error fetching Slack settings",
+				fmt.Sprintf("An error occurred: %s", err.Error()),
+			)
+			return current, diags
+		}
+	}
+
+	state := SlackSettingsModel{
+		Id:                  types.StringValue(slackSettingsId),
+		AppToken:            types.StringNull(),
+		AppTokenVersion:     current.AppTokenVersion,
+		AppTokenFingerprint: types.StringNull(),
+		BugReportChannel:    types.StringPointerValue(bugReportChannel),
+		TokenValid:          types.BoolValue(tokenValid != nil && *tokenValid),
+	}
+	if appToken != nil && *appToken != "" {
+		state.AppTokenFingerprint = types.StringValue(secretFingerprint(*appToken))
+	}
+
+	return state, diags
+}
+
+func shouldSetSlackAppToken(plan SlackSettingsModel, state SlackSettingsModel) bool {
+	return !plan.AppTokenVersion.Equal(state.AppTokenVersion) || state.AppTokenFingerprint.IsNull()
+}
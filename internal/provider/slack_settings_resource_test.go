@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestShouldSetSlackAppToken(t *testing.T) {
+	t.Parallel()
+
+	state := SlackSettingsModel{
+		AppTokenVersion:     types.StringValue("1"),
+		AppTokenFingerprint: types.StringValue("**xoxb**"),
+	}
+
+	t.Run("an unchanged token should not be set", func(t *testing.T) {
+		assert.False(t, shouldSetSlackAppToken(SlackSettingsModel{AppTokenVersion: types.StringValue("1")}, state))
+	})
+
+	t.Run("a new version should be set", func(t *testing.T) {
+		assert.True(t, shouldSetSlackAppToken(SlackSettingsModel{AppTokenVersion: types.StringValue("2")}, state))
+	})
+
+	t.Run("a token changed outside of Terraform should be set", func(t *testing.T) {
+		drifted := state
+		drifted.AppTokenFingerprint = types.StringNull()
+
+		assert.True(t, shouldSetSlackAppToken(SlackSettingsModel{AppTokenVersion: types.StringValue("1")}, drifted))
+	})
+}
@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/utils"
+)
+
+const tableDefinitionArchiveMessage = "Archived by Terraform"
+
+// The nouns used for segments and legacy metrics, which also determine the API used.
+const (
+	tableDefinitionSegment      = "segment"
+	tableDefinitionLegacyMetric = "legacy metric"
+)
+
+// tableDefinitionApi is implemented by the API services for segments and legacy metrics.
+type tableDefinitionApi interface {
+	Create(ctx context.Context, request *client.CreateTableDefinitionRequest) (*client.TableDefinition, error)
+	Get(ctx context.Context, id int64) (*client.TableDefinition, error)
+	Update(ctx context.Context, id int64, request *client.UpdateTableDefinitionRequest) (*client.TableDefinition, error)
+	Archive(ctx context.Context, id int64, revisionMessage string) error
+}
+
+// tableDefinitionResource implements the segment and legacy metric resources, which only differ in the API endpoints
+// they use.
+type tableDefinitionResource struct {
+	provider *MetabaseProvider
+	noun     string
+}
+
+type TableDefinitionModel struct {
+	Id              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	TableId         types.Int64  `tfsdk:"table_id"`
+	Definition      types.String `tfsdk:"definition"`
+	RevisionMessage types.String `tfsdk:"revision_message"`
+	CreatorId       types.Int64  `tfsdk:"creator_id"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+// tableDefinitionApi returns the API service for the type of table definition.
+func (p *MetabaseProvider) tableDefinitionApi(noun string) tableDefinitionApi {
+	if noun == tableDefinitionSegment {
+		return p.api.Segment
+	}
+
+	return p.api.LegacyMetric
+}
+
+func (r *tableDefinitionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schema.ResourceIdentity(r.noun)
+}
+
+func (r *tableDefinitionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when creating or destroying
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state TableDefinitionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RevisionMessage.IsNull() && tableDefinitionChanged(plan, state) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("revision_message"),
+			"Missing revision_message",
+			fmt.Sprintf("Metabase requires a message describing the change when a %s is updated.", r.noun),
+		)
+	}
+}
+
+func (r *tableDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TableDefinitionModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition, err := utils.UnmarshallJson(plan.Definition)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("definition"), "Configuration error", fmt.Sprintf("Error processing definition: %s", err.Error()))
+		return
+	}
+
+	tableDefinition, err := r.provider.tableDefinitionApi(r.noun).Create(ctx, &client.CreateTableDefinitionRequest{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueStringPointer(),
+		TableId:     plan.TableId.ValueInt64(),
+		Definition:  definition,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error creating %s", r.noun),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapTableDefinitionToState(tableDefinition, &plan)...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (r *tableDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TableDefinitionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.Id.ValueInt64()
+	tableDefinition, err := r.provider.tableDefinitionApi(r.noun).Get(ctx, id)
+	if err == nil && tableDefinition.Archived {
+		err = client.ErrNotFound
+	}
+	if err != nil {
+		diags = utils.HandleResourceReadError(ctx, r.noun, id, err, resp)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(mapTableDefinitionToState(tableDefinition, &state)...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func (r *tableDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TableDefinitionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Formatting the definition or changing the revision message alone doesn't need a new revision
+	if tableDefinitionChanged(plan, state) {
+		definition, err := utils.UnmarshallJson(plan.Definition)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("definition"), "Configuration error", fmt.Sprintf("Error processing definition: %s", err.Error()))
+			return
+		}
+
+		id := plan.Id.ValueInt64()
+		tableDefinition, err := r.provider.tableDefinitionApi(r.noun).Update(ctx, id, &client.UpdateTableDefinitionRequest{
+			Name:            plan.Name.ValueString(),
+			Description:     plan.Description.ValueStringPointer(),
+			Definition:      definition,
+			RevisionMessage: plan.RevisionMessage.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error updating %s with ID %d", r.noun, id),
+				fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(mapTableDefinitionToState(tableDefinition, &plan)...)
+	}
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (r *tableDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TableDefinitionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.Id.ValueInt64()
+	err := r.provider.tableDefinitionApi(r.noun).Archive(ctx, id, tableDefinitionArchiveMessage)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error archiving %s with ID %d", r.noun, id),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (r *tableDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, diags := r.provider.resolveImportId(ctx, req, fmt.Sprintf("the numeric ID of the %s", r.noun))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tableDefinition, err := r.provider.tableDefinitionApi(r.noun).Get(ctx, id)
+	if err == nil && tableDefinition.Archived {
+		err = fmt.Errorf("%s %d has been archived", r.noun, id)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing %s with ID %d", r.noun, id),
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	var state TableDefinitionModel
+	resp.Diagnostics.Append(mapTableDefinitionToState(tableDefinition, &state)...)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+// tableDefinitionChanged returns whether the planned values would create a new revision, ignoring any formatting
+// changes to the definition.
+func tableDefinitionChanged(plan TableDefinitionModel, state TableDefinitionModel) bool {
+	if plan.Name.IsUnknown() || plan.Description.IsUnknown() || plan.Definition.IsUnknown() {
+		return true
+	}
+
+	return !plan.Name.Equal(state.Name) ||
+		!plan.Description.Equal(state.Description) ||
+		!utils.JsonEquivalent(plan.Definition.ValueString(), state.Definition.ValueString())
+}
+
+func mapTableDefinitionToState(tableDefinition *client.TableDefinition, target *TableDefinitionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	target.Id = types.Int64Value(tableDefinition.Id)
+	target.Name = types.StringValue(tableDefinition.Name)
+	target.Description = types.StringPointerValue(tableDefinition.Description)
+	target.TableId = types.Int64Value(tableDefinition.TableId)
+	target.CreatorId = types.Int64Value(tableDefinition.CreatorId)
+	target.CreatedAt = types.StringValue(tableDefinition.CreatedAt)
+
+	// Use the configured definition so the state matches the config, provided they're equivalent
+	definition, err := json.Marshal(tableDefinition.Definition)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error parsing the definition with ID %d", tableDefinition.Id), err.Error())
+	} else if target.Definition.IsNull() || target.Definition.IsUnknown() || !utils.JsonEquivalent(target.Definition.ValueString(), string(definition)) {
+		target.Definition = types.StringValue(string(definition))
+	}
+
+	return diags
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"terraform-provider-metabase/internal/client"
+	"testing"
+)
+
+func TestTableDefinitionChanged(t *testing.T) {
+	t.Parallel()
+
+	state := TableDefinitionModel{
+		Name:        types.StringValue("Active"),
+		Description: types.StringNull(),
+		Definition:  types.StringValue(`{"source-table":1,"filter":["=",["field",2,null],"active"]}`),
+	}
+
+	t.Run("formatting the definition should not be a change", func(t *testing.T) {
+		plan := state
+		plan.Definition = types.StringValue("{\n  \"filter\": [\"=\", [\"field\", 2, null], \"active\"],\n  \"source-table\": 1\n}")
+		plan.RevisionMessage = types.StringValue("Reformatted")
+
+		assert.False(t, tableDefinitionChanged(plan, state))
+	})
+
+	t.Run("changing the definition should be a change", func(t *testing.T) {
+		plan := state
+		plan.Definition = types.StringValue(`{"source-table":1,"filter":["=",["field",2,null],"inactive"]}`)
+
+		assert.True(t, tableDefinitionChanged(plan, state))
+	})
+
+	t.Run("changing the description should be a change", func(t *testing.T) {
+		plan := state
+		plan.Description = types.StringValue("Customers who are active")
+
+		assert.True(t, tableDefinitionChanged(plan, state))
+	})
+}
+
+func TestMapTableDefinitionToState(t *testing.T) {
+	t.Parallel()
+
+	tableDefinition := &client.TableDefinition{
+		Id:         1,
+		Name:       "Active",
+		TableId:    2,
+		Definition: map[string]any{"source-table": 2, "filter": []any{"=", []any{"field", 3, nil}, "active"}},
+	}
+
+	t.Run("an equivalent definition should be kept", func(t *testing.T) {
+		configured := `{ "filter": ["=", ["field", 3, null], "active"], "source-table": 2 }`
+		state := TableDefinitionModel{Definition: types.StringValue(configured)}
+
+		diags := mapTableDefinitionToState(tableDefinition, &state)
+
+		assert.Empty(t, diags)
+		assert.Equal(t, configured, state.Definition.ValueString())
+		assert.True(t, state.Description.IsNull())
+	})
+
+	t.Run("a changed definition should be updated", func(t *testing.T) {
+		state := TableDefinitionModel{Definition: types.StringValue(`{"source-table": 2}`)}
+
+		diags := mapTableDefinitionToState(tableDefinition, &state)
+
+		assert.Empty(t, diags)
+		assert.JSONEq(t, `{"filter":["=",["field",3,null],"active"],"source-table":2}`, state.Definition.ValueString())
+	})
+}
+
+func testAccTableDefinitionConfig(resourceType string, name string, definition string, revisionMessage string) string {
+	return providerConfig + fmt.Sprintf(`
+data "metabase_database_metadata" "test" {
+	database_id = %d
+	schema      = "PUBLIC"
+	table_name  = "ORDERS"
+}
+locals {
+	orders   = data.metabase_database_metadata.test.tables[0]
+	quantity = one([for field in local.orders.fields : field.id if field.name == "QUANTITY"])
+}
+resource "%s" "test" {
+	name             = "%s"
+	table_id         = local.orders.id
+	definition       = %s
+	revision_message = %s
+}
+`, testAccSampleDatabaseId, resourceType, name, definition, revisionMessage)
+}
+
+func TestAccSegmentResource_Basic(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableDefinitionConfig("metabase_segment", name, `jsonencode({
+		"source-table" = local.orders.id
+		filter         = [">", ["field", local.quantity, null], 10]
+	})`, "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("metabase_segment.test", "id"),
+					resource.TestCheckResourceAttr("metabase_segment.test", "name", name),
+					resource.TestCheckResourceAttrPair("metabase_segment.test", "table_id", "data.metabase_database_metadata.test", "tables.0.id"),
+				),
+			},
+			{
+				ResourceName:            "metabase_segment.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition"},
+			},
+			{
+				Config: testAccTableDefinitionConfig("metabase_segment", name, `jsonencode({
+		"source-table" = local.orders.id
+		filter         = [">", ["field", local.quantity, null], 20]
+	})`, "null"),
+				ExpectError: regexp.MustCompile("Missing revision_message"),
+			},
+			{
+				Config: testAccTableDefinitionConfig("metabase_segment", name, `jsonencode({
+		"source-table" = local.orders.id
+		filter         = [">", ["field", local.quantity, null], 20]
+	})`, `"Increased the threshold"`),
+				Check: resource.TestCheckResourceAttr("metabase_segment.test", "revision_message", "Increased the threshold"),
+			},
+		},
+	})
+}
+
+func TestAccLegacyMetricResource_Basic(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableDefinitionConfig("metabase_legacy_metric", name, `jsonencode({
+		"source-table" = local.orders.id
+		aggregation    = [["sum", ["field", local.quantity, null]]]
+	})`, "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("metabase_legacy_metric.test", "id"),
+					resource.TestCheckResourceAttr("metabase_legacy_metric.test", "name", name),
+				),
+			},
+			{
+				ResourceName:            "metabase_legacy_metric.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition"},
+			},
+		},
+	})
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ list.ListResource = &TableListResource{}
+
+type TableListResource struct {
+	provider *MetabaseProvider
+}
+
+type TableListResourceModel struct {
+	DatabaseId types.Int64  `tfsdk:"database_id"`
+	Schema     types.String `tfsdk:"schema"`
+}
+
+func (l *TableListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_table"
+}
+
+func (l *TableListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.TableListResource()
+}
+
+func (l *TableListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config TableListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tables, err := l.provider.api.Table.List(ctx)
+	if err != nil {
+		stream.Results = listError("Failed to list tables", err)
+		return
+	}
+
+	tables = filterTables(tables, &config)
+	stream.Results = streamListResults(ctx, req, tables, func(table client.Table, result *list.ListResult) {
+		var state TableModel
+		mapTableToState(&table, &state)
+
+		result.DisplayName = table.Name
+		if table.Schema != nil {
+			result.DisplayName = *table.Schema + "." + table.Name
+		}
+		l.provider.setListResult(ctx, req, result, table.Id, state)
+	})
+}
+
+// filterTables removes any inactive tables and tables not matching the filters, and sorts the tables by ID so the
+// results are stable.
+func filterTables(tables []client.Table, config *TableListResourceModel) []client.Table {
+	filtered := make([]client.Table, 0, len(tables))
+	for _, table := range tables {
+		if !table.Active {
+			continue
+		}
+		if !config.DatabaseId.IsNull() && table.DbId != config.DatabaseId.ValueInt64() {
+			continue
+		}
+		if !config.Schema.IsNull() && (table.Schema == nil || *table.Schema != config.Schema.ValueString()) {
+			continue
+		}
+
+		filtered = append(filtered, table)
+	}
+
+	slices.SortFunc(filtered, func(a, b client.Table) bool {
+		return a.Id < b.Id
+	})
+
+	return filtered
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/transforms"
+	"terraform-provider-metabase/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &TableResource{}
+var _ resource.ResourceWithIdentity = &TableResource{}
+var _ resource.ResourceWithImportState = &TableResource{}
+
+type TableResource struct {
+	provider *MetabaseProvider
+}
+
+type TableModel struct {
+	Id         types.Int64  `tfsdk:"id"`
+	DatabaseId types.Int64  `tfsdk:"database_id"`
+	Schema     types.String `tfsdk:"schema"`
+	Name       types.String `tfsdk:"name"`
+
+	DisplayName      types.String `tfsdk:"display_name"`
+	Description      types.String `tfsdk:"description"`
+	Caveats          types.String `tfsdk:"caveats"`
+	PointsOfInterest types.String `tfsdk:"points_of_interest"`
+	VisibilityType   types.String `tfsdk:"visibility_type"`
+	EntityType       types.String `tfsdk:"entity_type"`
+	FieldOrder       types.String `tfsdk:"field_order"`
+	Active           types.Bool   `tfsdk:"active"`
+}
+
+func (t *TableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_table"
+}
+
+func (t *TableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.TableResource()
+}
+
+func (t *TableResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schema.ResourceIdentity("table")
+}
+
+func (t *TableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TableModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tables are created by syncing the database, so we adopt the existing table
+	table, err := t.provider.api.Table.Find(ctx, plan.DatabaseId.ValueInt64(), transforms.FromTerraformString(plan.Schema), plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error finding table",
+			fmt.Sprintf("Unable to find the table. Check the database has been synced. An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	state, diags := t.updateTable(ctx, table.Id, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(t.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func (t *TableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TableModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tableId := state.Id.ValueInt64()
+	table, err := t.provider.api.Table.Get(ctx, tableId)
+	if err != nil {
+		diags = utils.HandleResourceReadError(ctx, "table", tableId, err, resp)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	mapTableToState(table, &state)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(t.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func (t *TableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TableModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := t.updateTable(ctx, plan.Id.ValueInt64(), plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(t.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func (t *TableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Tables can't be deleted, and we deliberately leave the metadata as it is, so there's nothing to do other than
+	// remove the table from the state (which is done automatically).
+}
+
+func (t *TableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tableId, diags := t.provider.resolveImportId(ctx, req, "the numeric ID of the table")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	table, err := t.provider.api.Table.Get(ctx, tableId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing table with ID %d", tableId),
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	var state TableModel
+	mapTableToState(table, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(t.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func (t *TableResource) updateTable(ctx context.Context, tableId int64, plan TableModel) (TableModel, diag.Diagnostics) {
+	err := t.provider.api.Table.Update(ctx, tableId, &client.UpdateTableRequest{
+		DisplayName:      transforms.FromTerraformString(plan.DisplayName),
+		Description:      transforms.FromTerraformString(plan.Description),
+		Caveats:          transforms.FromTerraformString(plan.Caveats),
+		PointsOfInterest: transforms.FromTerraformString(plan.PointsOfInterest),
+		VisibilityType:   transforms.FromTerraformString(plan.VisibilityType),
+		EntityType:       transforms.FromTerraformString(plan.EntityType),
+		FieldOrder:       transforms.FromTerraformString(plan.FieldOrder),
+	})
+	if err != nil {
+		return TableModel{}, diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				fmt.Sprintf("Error updating table with ID %d", tableId),
+				fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+			),
+		}
+	}
+
+	table, err := t.provider.api.Table.Get(ctx, tableId)
+	if err != nil {
+		return TableModel{}, diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				fmt.Sprintf("Error fetching table with ID %d", tableId),
+				fmt.Sprintf("An unexpected error occurred: %s", err.Error()),
+			),
+		}
+	}
+
+	var state TableModel
+	mapTableToState(table, &state)
+	state.ensureConsistentPlan(&plan)
+
+	return state, nil
+}
+
+func mapTableToState(table *client.Table, target *TableModel) {
+	target.Id = types.Int64Value(table.Id)
+	target.DatabaseId = types.Int64Value(table.DbId)
+	target.Schema = transforms.ToTerraformString(table.Schema)
+	target.Name = types.StringValue(table.Name)
+
+	target.DisplayName = types.StringValue(table.DisplayName)
+	target.Description = transforms.ToTerraformString(table.Description)
+	target.Caveats = transforms.ToTerraformString(table.Caveats)
+	target.PointsOfInterest = transforms.ToTerraformString(table.PointsOfInterest)
+	target.VisibilityType = transforms.ToTerraformString(table.VisibilityType)
+	target.EntityType = transforms.ToTerraformString(table.EntityType)
+	target.FieldOrder = types.StringValue(table.FieldOrder)
+	target.Active = types.BoolValue(table.Active)
+}
+
+func (state *TableModel) ensureConsistentPlan(plan *TableModel) {
+	// The schema is used to identify the table, so must match the config
+	if !plan.Schema.IsUnknown() {
+		state.Schema = plan.Schema
+	}
+}
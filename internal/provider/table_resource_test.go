@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"regexp"
+	"testing"
+)
+
+// The sample database is loaded automatically by Metabase when it is set up
+const testAccSampleDatabaseId = 1
+
+func TestAccTableResource_Basic(t *testing.T) {
+	description := acctest.RandString(20)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_table" "test" {
+	database_id = %d
+	schema      = "PUBLIC"
+	name        = "PRODUCTS"
+
+	display_name    = "Catalogue"
+	description     = "%s"
+	visibility_type = "technical"
+	entity_type     = "entity/ProductTable"
+	field_order     = "alphabetical"
+}
+`, testAccSampleDatabaseId, description),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("metabase_table.test", "id"),
+					resource.TestCheckResourceAttr("metabase_table.test", "display_name", "Catalogue"),
+					resource.TestCheckResourceAttr("metabase_table.test", "description", description),
+					resource.TestCheckResourceAttr("metabase_table.test", "visibility_type", "technical"),
+					resource.TestCheckResourceAttr("metabase_table.test", "entity_type", "entity/ProductTable"),
+					resource.TestCheckResourceAttr("metabase_table.test", "field_order", "alphabetical"),
+					resource.TestCheckResourceAttr("metabase_table.test", "active", "true"),
+				),
+			},
+			{
+				ResourceName:      "metabase_table.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_table" "test" {
+	database_id = %d
+	schema      = "PUBLIC"
+	name        = "PRODUCTS"
+
+	display_name = "Products"
+	field_order  = "database"
+}
+`, testAccSampleDatabaseId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_table.test", "display_name", "Products"),
+					resource.TestCheckNoResourceAttr("metabase_table.test", "description"),
+					resource.TestCheckNoResourceAttr("metabase_table.test", "visibility_type"),
+					resource.TestCheckResourceAttr("metabase_table.test", "field_order", "database"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTableResource_NotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_table" "test" {
+	database_id = %d
+	schema      = "PUBLIC"
+	name        = "DOES_NOT_EXIST"
+}
+`, testAccSampleDatabaseId),
+				ExpectError: regexp.MustCompile("Error finding table"),
+			},
+		},
+	})
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/transforms"
+	"terraform-provider-metabase/internal/utils"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &TimelineEventResource{}
+var _ resource.ResourceWithIdentity = &TimelineEventResource{}
+var _ resource.ResourceWithImportState = &TimelineEventResource{}
+
+type TimelineEventResource struct {
+	provider *MetabaseProvider
+}
+
+type TimelineEventModel struct {
+	Id          types.Int64  `tfsdk:"id"`
+	TimelineId  types.Int64  `tfsdk:"timeline_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Timestamp   types.String `tfsdk:"timestamp"`
+	TimeMatters types.Bool   `tfsdk:"time_matters"`
+	Timezone    types.String `tfsdk:"timezone"`
+	Icon        types.String `tfsdk:"icon"`
+	CreatorId   types.Int64  `tfsdk:"creator_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func (e *TimelineEventResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_timeline_event"
+}
+
+func (e *TimelineEventResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.TimelineEventResource()
+}
+
+func (e *TimelineEventResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schema.ResourceIdentity("timeline event")
+}
+
+func (e *TimelineEventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TimelineEventModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	event, err := e.provider.api.TimelineEvent.Create(ctx, buildTimelineEventRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating timeline event",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	mapTimelineEventToState(event, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(e.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (e *TimelineEventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TimelineEventModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventId := state.Id.ValueInt64()
+	event, err := e.provider.api.TimelineEvent.Get(ctx, eventId)
+	if err == nil && event.Archived {
+		err = client.ErrNotFound
+	}
+	if err != nil {
+		diags = utils.HandleResourceReadError(ctx, "timeline event", eventId, err, resp)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	mapTimelineEventToState(event, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(e.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func (e *TimelineEventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TimelineEventModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventId := plan.Id.ValueInt64()
+	event, err := e.provider.api.TimelineEvent.Update(ctx, eventId, buildTimelineEventRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error updating timeline event with ID %d", eventId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	mapTimelineEventToState(event, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(e.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (e *TimelineEventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TimelineEventModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventId := state.Id.ValueInt64()
+	err := e.provider.api.TimelineEvent.Archive(ctx, eventId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error archiving timeline event with ID %d", eventId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (e *TimelineEventResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	eventId, diags := e.provider.resolveImportId(ctx, req, "the numeric ID of the timeline event")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	event, err := e.provider.api.TimelineEvent.Get(ctx, eventId)
+	if err == nil && event.Archived {
+		err = fmt.Errorf("timeline event %d has been archived", eventId)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing timeline event with ID %d", eventId),
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	var state TimelineEventModel
+	mapTimelineEventToState(event, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(e.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func buildTimelineEventRequest(plan TimelineEventModel) *client.TimelineEventRequest {
+	return &client.TimelineEventRequest{
+		TimelineId:  plan.TimelineId.ValueInt64(),
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueStringPointer(),
+		Timestamp:   plan.Timestamp.ValueString(),
+		TimeMatters: plan.TimeMatters.ValueBool(),
+		Timezone:    plan.Timezone.ValueString(),
+		Icon:        transforms.FromTerraformString(plan.Icon),
+	}
+}
+
+func mapTimelineEventToState(event *client.TimelineEvent, target *TimelineEventModel) {
+	target.Id = types.Int64Value(event.Id)
+	target.TimelineId = types.Int64Value(event.TimelineId)
+	target.Name = types.StringValue(event.Name)
+	target.Description = transforms.ToTerraformString(event.Description)
+	target.TimeMatters = types.BoolValue(event.TimeMatters)
+	target.Timezone = types.StringValue(event.Timezone)
+	target.Icon = types.StringValue(event.Icon)
+	target.CreatorId = types.Int64Value(event.CreatorId)
+	target.CreatedAt = types.StringValue(event.CreatedAt)
+
+	// Metabase normalises the timestamp, so keep the existing value if it's the same instant
+	if target.Timestamp.IsNull() || target.Timestamp.IsUnknown() || !timestampsEquivalent(target.Timestamp.ValueString(), event.Timestamp) {
+		target.Timestamp = types.StringValue(event.Timestamp)
+	}
+}
+
+// timestampsEquivalent returns whether two RFC 3339 timestamps represent the same instant. Invalid timestamps are never
+// equivalent.
+func timestampsEquivalent(a string, b string) bool {
+	aTime, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return false
+	}
+	bTime, err := time.Parse(time.RFC3339, b)
+	if err != nil {
+		return false
+	}
+
+	return aTime.Equal(bTime)
+}
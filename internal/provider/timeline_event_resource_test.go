@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-metabase/internal/client"
+	"testing"
+)
+
+func TestMapTimelineEventToState(t *testing.T) {
+	t.Parallel()
+
+	event := &client.TimelineEvent{
+		Id:          1,
+		TimelineId:  2,
+		Name:        "Launch",
+		Timestamp:   "2026-03-01T09:00:00Z",
+		TimeMatters: true,
+		Timezone:    "Europe/London",
+		Icon:        "star",
+	}
+
+	t.Run("timestamp for the same instant should be kept", func(t *testing.T) {
+		state := TimelineEventModel{
+			Timestamp: types.StringValue("2026-03-01T10:00:00+01:00"),
+		}
+
+		mapTimelineEventToState(event, &state)
+
+		assert.Equal(t, "2026-03-01T10:00:00+01:00", state.Timestamp.ValueString())
+	})
+
+	t.Run("changed timestamp should be updated", func(t *testing.T) {
+		state := TimelineEventModel{
+			Timestamp: types.StringValue("2026-03-02T09:00:00Z"),
+		}
+
+		mapTimelineEventToState(event, &state)
+
+		assert.Equal(t, event.Timestamp, state.Timestamp.ValueString())
+	})
+
+	t.Run("missing timestamp should be set", func(t *testing.T) {
+		var state TimelineEventModel
+
+		mapTimelineEventToState(event, &state)
+
+		assert.Equal(t, event.Timestamp, state.Timestamp.ValueString())
+		assert.Equal(t, int64(2), state.TimelineId.ValueInt64())
+		assert.True(t, state.Description.IsNull())
+	})
+}
+
+func TestBuildTimelineEventRequest(t *testing.T) {
+	t.Parallel()
+
+	plan := TimelineEventModel{
+		TimelineId:  types.Int64Value(1),
+		Name:        types.StringValue("Launch"),
+		Description: types.StringNull(),
+		Timestamp:   types.StringValue("2024-01-01T09:00:00Z"),
+		TimeMatters: types.BoolValue(false),
+		Timezone:    types.StringValue("UTC"),
+	}
+
+	t.Run("an unknown icon should be omitted so the timeline's icon is used", func(t *testing.T) {
+		plan.Icon = types.StringUnknown()
+
+		request := buildTimelineEventRequest(plan)
+
+		assert.Nil(t, request.Icon)
+		assert.Nil(t, request.Description)
+	})
+
+	t.Run("a configured icon should be sent", func(t *testing.T) {
+		plan.Icon = types.StringValue("bell")
+
+		request := buildTimelineEventRequest(plan)
+
+		if assert.NotNil(t, request.Icon) {
+			assert.Equal(t, "bell", *request.Icon)
+		}
+	})
+}
+
+func TestTimestampsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, timestampsEquivalent("2026-03-01T09:00:00Z", "2026-03-01T09:00:00.000Z"))
+	assert.True(t, timestampsEquivalent("2026-03-01T09:00:00Z", "2026-03-01T04:00:00-05:00"))
+	assert.False(t, timestampsEquivalent("2026-03-01T09:00:00Z", "2026-03-01T09:00:01Z"))
+	assert.False(t, timestampsEquivalent("2026-03-01", "2026-03-01T00:00:00Z"))
+}
+
+func TestAccTimelineEventResource_Basic(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_timeline" "test" {
+	name = "%[1]s"
+	icon = "balloons"
+}
+
+resource "metabase_timeline_event" "test" {
+	timeline_id = metabase_timeline.test.id
+	name        = "%[1]s"
+	timestamp   = "2026-03-01T09:00:00Z"
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("metabase_timeline_event.test", "id"),
+					resource.TestCheckResourceAttrPair("metabase_timeline_event.test", "timeline_id", "metabase_timeline.test", "id"),
+					resource.TestCheckResourceAttr("metabase_timeline_event.test", "timestamp", "2026-03-01T09:00:00Z"),
+					resource.TestCheckResourceAttr("metabase_timeline_event.test", "time_matters", "false"),
+					resource.TestCheckResourceAttr("metabase_timeline_event.test", "timezone", "UTC"),
+					resource.TestCheckResourceAttr("metabase_timeline_event.test", "icon", "balloons"),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_timeline" "test" {
+	name = "%[1]s"
+	icon = "balloons"
+}
+
+resource "metabase_timeline_event" "test" {
+	timeline_id  = metabase_timeline.test.id
+	name         = "%[1]s"
+	description  = "Rescheduled"
+	timestamp    = "2026-03-02T14:30:00Z"
+	time_matters = true
+	timezone     = "Europe/London"
+	icon         = "warning"
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_timeline_event.test", "description", "Rescheduled"),
+					resource.TestCheckResourceAttr("metabase_timeline_event.test", "time_matters", "true"),
+					resource.TestCheckResourceAttr("metabase_timeline_event.test", "timezone", "Europe/London"),
+					resource.TestCheckResourceAttr("metabase_timeline_event.test", "icon", "warning"),
+				),
+			},
+			{
+				ResourceName:            "metabase_timeline_event.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timestamp"},
+			},
+		},
+	})
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/transforms"
+	"terraform-provider-metabase/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &TimelineResource{}
+var _ resource.ResourceWithIdentity = &TimelineResource{}
+var _ resource.ResourceWithImportState = &TimelineResource{}
+
+type TimelineResource struct {
+	provider *MetabaseProvider
+}
+
+type TimelineModel struct {
+	Id           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Icon         types.String `tfsdk:"icon"`
+	CollectionId types.Int64  `tfsdk:"collection_id"`
+	Default      types.Bool   `tfsdk:"default"`
+	CreatorId    types.Int64  `tfsdk:"creator_id"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+func (t *TimelineResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_timeline"
+}
+
+func (t *TimelineResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.TimelineResource()
+}
+
+func (t *TimelineResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schema.ResourceIdentity("timeline")
+}
+
+func (t *TimelineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TimelineModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeline, err := t.provider.api.Timeline.Create(ctx, buildTimelineRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating timeline",
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	mapTimelineToState(timeline, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(t.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (t *TimelineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TimelineModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timelineId := state.Id.ValueInt64()
+	timeline, err := t.provider.api.Timeline.Get(ctx, timelineId)
+	if err == nil && timeline.Archived {
+		err = client.ErrNotFound
+	}
+	if err != nil {
+		diags = utils.HandleResourceReadError(ctx, "timeline", timelineId, err, resp)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	mapTimelineToState(timeline, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(t.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func (t *TimelineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TimelineModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timelineId := plan.Id.ValueInt64()
+	timeline, err := t.provider.api.Timeline.Update(ctx, timelineId, buildTimelineRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error updating timeline with ID %d", timelineId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	mapTimelineToState(timeline, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(t.provider.setResourceIdentity(ctx, resp.Identity, plan.Id.ValueInt64())...)
+}
+
+func (t *TimelineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TimelineModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timelineId := state.Id.ValueInt64()
+	err := t.provider.api.Timeline.Archive(ctx, timelineId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error archiving timeline with ID %d", timelineId),
+			fmt.Sprintf("Unexpected error occurred: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (t *TimelineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	timelineId, diags := t.provider.resolveImportId(ctx, req, "the numeric ID of the timeline")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeline, err := t.provider.api.Timeline.Get(ctx, timelineId)
+	if err == nil && timeline.Archived {
+		err = fmt.Errorf("timeline %d has been archived", timelineId)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing timeline with ID %d", timelineId),
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	var state TimelineModel
+	mapTimelineToState(timeline, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(t.provider.setResourceIdentity(ctx, resp.Identity, state.Id.ValueInt64())...)
+}
+
+func buildTimelineRequest(plan TimelineModel) *client.TimelineRequest {
+	return &client.TimelineRequest{
+		Name:         plan.Name.ValueString(),
+		Description:  plan.Description.ValueStringPointer(),
+		Icon:         plan.Icon.ValueStringPointer(),
+		CollectionId: plan.CollectionId.ValueInt64Pointer(),
+		Default:      plan.Default.ValueBool(),
+	}
+}
+
+func mapTimelineToState(timeline *client.Timeline, target *TimelineModel) {
+	target.Id = types.Int64Value(timeline.Id)
+	target.Name = types.StringValue(timeline.Name)
+	target.Description = transforms.ToTerraformString(timeline.Description)
+	target.Icon = types.StringValue(timeline.Icon)
+	target.CollectionId = transforms.ToTerraformInt(timeline.CollectionId)
+	target.Default = types.BoolValue(timeline.Default)
+	target.CreatorId = types.Int64Value(timeline.CreatorId)
+	target.CreatedAt = types.StringValue(timeline.CreatedAt)
+}
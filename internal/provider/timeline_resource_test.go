@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccTimelineResource_Basic(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_timeline" "test" {
+	name = "%s"
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("metabase_timeline.test", "id"),
+					resource.TestCheckResourceAttr("metabase_timeline.test", "name", name),
+					resource.TestCheckResourceAttr("metabase_timeline.test", "icon", "star"),
+					resource.TestCheckResourceAttr("metabase_timeline.test", "default", "false"),
+					resource.TestCheckNoResourceAttr("metabase_timeline.test", "collection_id"),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_timeline" "test" {
+	name        = "%s"
+	description = "Product releases"
+	icon        = "bell"
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_timeline.test", "description", "Product releases"),
+					resource.TestCheckResourceAttr("metabase_timeline.test", "icon", "bell"),
+				),
+			},
+			{
+				ResourceName:      "metabase_timeline.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
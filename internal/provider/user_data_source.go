@@ -2,17 +2,35 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"github.com/bnjns/metabase-sdk-go/service/user"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-metabase/internal/client"
 	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/validators"
+)
+
+var (
+	errUserNotFound       = errors.New("no user matches the provided email address")
+	errMultipleUsersFound = errors.New("more than one user matches the provided email address")
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ datasource.DataSource = &UserDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &UserDataSource{}
 
 type UserDataSource struct {
 	provider *MetabaseProvider
 }
 
+type UserDataSourceModel struct {
+	UserModel
+	IncludeDeactivated types.Bool `tfsdk:"include_deactivated"`
+}
+
 func (t *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user"
 }
@@ -21,21 +39,86 @@ func (t *UserDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 	resp.Schema = schema.UserDataSource(schema.DataSourceTypeUser)
 }
 
+func (t *UserDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		validators.ExactlyOneOfValidator("id", "email"),
+	}
+}
+
 func (t *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get the current state, in the desired struct
-	var data UserResourceModel
+	var data UserDataSourceModel
 	diags := req.Config.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	diags = t.provider.syncUserWithApi(ctx, &data)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	// Only the API's list endpoint can return deactivated users or look them up by email
+	if data.Email.IsNull() && !data.IncludeDeactivated.ValueBool() {
+		diags = t.provider.syncUserWithApi(ctx, &data.UserModel)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		usr, err := t.findUser(ctx, &data)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to find user",
+				fmt.Sprintf("An error occurred: %s", err.Error()),
+			)
+			return
+		}
+
+		mapUserToState(usr, &data.UserModel)
 	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
+
+// findUser searches for the user matching either the ID or email address in the config.
+func (t *UserDataSource) findUser(ctx context.Context, data *UserDataSourceModel) (*user.User, error) {
+	request := &client.ListUsersRequest{}
+	if data.IncludeDeactivated.ValueBool() {
+		request.Status = "all"
+	}
+	if !data.Email.IsNull() {
+		email := data.Email.ValueString()
+		request.Query = &email
+	}
+
+	users, err := t.provider.api.User.List(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return matchUser(users, data)
+}
+
+// matchUser returns the single user matching the ID or email address in the config, erroring if there is not exactly
+// one match.
+func matchUser(users []user.User, data *UserDataSourceModel) (*user.User, error) {
+	matches := make([]user.User, 0, 1)
+	for _, usr := range users {
+		if data.Email.IsNull() {
+			if usr.Id == data.Id.ValueInt64() {
+				matches = append(matches, usr)
+			}
+		} else if strings.EqualFold(usr.Email, data.Email.ValueString()) {
+			matches = append(matches, usr)
+		}
+	}
+
+	if len(matches) == 0 {
+		if data.Email.IsNull() {
+			return nil, fmt.Errorf("user %d not found", data.Id.ValueInt64())
+		}
+		return nil, fmt.Errorf("%w: %s", errUserNotFound, data.Email.ValueString())
+	} else if len(matches) > 1 {
+		return nil, fmt.Errorf("%w: %s", errMultipleUsersFound, data.Email.ValueString())
+	}
+
+	return &matches[0], nil
+}
@@ -2,10 +2,69 @@ package provider
 
 import (
 	"fmt"
+	"github.com/bnjns/metabase-sdk-go/service/user"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"regexp"
 	"testing"
 )
 
+func TestMatchUser(t *testing.T) {
+	t.Parallel()
+
+	users := []user.User{
+		{Id: 1, Email: "Example@Example.com"},
+		{Id: 2, Email: "duplicate@example.com"},
+		{Id: 3, Email: "DUPLICATE@example.com"},
+	}
+
+	t.Run("matching by email should be case-insensitive", func(t *testing.T) {
+		data := UserDataSourceModel{}
+		data.Email = types.StringValue("example@example.com")
+
+		usr, err := matchUser(users, &data)
+
+		assert.Nil(t, err)
+		if assert.NotNil(t, usr) {
+			assert.Equal(t, int64(1), usr.Id)
+		}
+	})
+
+	t.Run("matching by ID should return the user", func(t *testing.T) {
+		data := UserDataSourceModel{}
+		data.Email = types.StringNull()
+		data.Id = types.Int64Value(2)
+
+		usr, err := matchUser(users, &data)
+
+		assert.Nil(t, err)
+		if assert.NotNil(t, usr) {
+			assert.Equal(t, "duplicate@example.com", usr.Email)
+		}
+	})
+
+	t.Run("no matches should return an error", func(t *testing.T) {
+		data := UserDataSourceModel{}
+		data.Email = types.StringValue("missing@example.com")
+
+		usr, err := matchUser(users, &data)
+
+		assert.Nil(t, usr)
+		assert.ErrorIs(t, err, errUserNotFound)
+	})
+
+	t.Run("multiple matches should return an error", func(t *testing.T) {
+		data := UserDataSourceModel{}
+		data.Email = types.StringValue("duplicate@example.com")
+
+		usr, err := matchUser(users, &data)
+
+		assert.Nil(t, usr)
+		assert.ErrorIs(t, err, errMultipleUsersFound)
+	})
+}
+
 func TestAccUserDataSource_Basic(t *testing.T) {
 	userEmail := testAccRandEmail()
 
@@ -54,3 +113,64 @@ data "metabase_user" "test" {
 		},
 	})
 }
+
+func TestAccUserDataSource_Email(t *testing.T) {
+	userEmail := testAccRandEmail()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_user" "test" {
+	email      = "%s"
+	first_name = "%s"
+	last_name  = "%s"
+}
+
+data "metabase_user" "test" {
+	email = metabase_user.test.email
+}
+`, userEmail, testAccUserFirstName, testAccUserLastName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserConf("data.metabase_user.test", userEmail, testAccUserFirstName, testAccUserLastName, false),
+					resource.TestCheckResourceAttrPair("data.metabase_user.test", "id", "metabase_user.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_EmailNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "metabase_user" "test" {
+	email               = "%s"
+	include_deactivated = true
+}
+`, testAccRandEmail()),
+				ExpectError: regexp.MustCompile("no user matches the provided email address"),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_IdAndEmail(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "metabase_user" "test" {
+	id    = 1
+	email = "example@example.com"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid attribute combination"),
+			},
+		},
+	})
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"github.com/bnjns/metabase-sdk-go/service/user"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/transforms"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ list.ListResource = &UserListResource{}
+
+type UserListResource struct {
+	provider *MetabaseProvider
+}
+
+type UserListResourceModel struct {
+	EmailDomain types.String `tfsdk:"email_domain"`
+	GroupId     types.Int64  `tfsdk:"group_id"`
+	Status      types.String `tfsdk:"status"`
+	SSOSource   types.String `tfsdk:"sso_source"`
+}
+
+func (l *UserListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (l *UserListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.UserListResource()
+}
+
+func (l *UserListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config UserListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var query *string
+	if !config.EmailDomain.IsNull() {
+		domainQuery := "@" + config.EmailDomain.ValueString()
+		query = &domainQuery
+	}
+
+	users, err := l.provider.api.User.List(ctx, &client.ListUsersRequest{
+		Status:  config.Status.ValueString(),
+		Query:   query,
+		GroupId: transforms.FromTerraformInt(config.GroupId),
+	})
+	if err != nil {
+		stream.Results = listError("Failed to list users", err)
+		return
+	}
+
+	// The filters are the same as the metabase_users data source, so we can reuse its exact matching
+	users = filterUsers(users, &UsersDataSourceModel{
+		EmailDomain: config.EmailDomain,
+		SSOSource:   config.SSOSource,
+	})
+
+	stream.Results = streamListResults(ctx, req, users, func(usr user.User, result *list.ListResult) {
+		var state UserResourceModel
+		mapUserToState(&usr, &state.UserModel)
+
+		result.DisplayName = usr.Email
+		result.Diagnostics.Append(l.provider.setUserIdentity(ctx, result.Identity, &state.UserModel)...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+		}
+	})
+}
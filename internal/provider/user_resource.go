@@ -5,33 +5,46 @@ import (
 	"fmt"
 	"github.com/bnjns/metabase-sdk-go/service/permissions"
 	"github.com/bnjns/metabase-sdk-go/service/user"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/exp/slices"
-	"strconv"
 	"strings"
+	"terraform-provider-metabase/internal/client"
 	"terraform-provider-metabase/internal/schema"
 	"terraform-provider-metabase/internal/transforms"
+	"terraform-provider-metabase/internal/utils"
 	"terraform-provider-metabase/internal/validators"
 )
 
+// userImportedDeactivatedPrivateKey is set when a deactivated user is imported, so that they aren't reactivated until
+// the next apply.
+const userImportedDeactivatedPrivateKey = "imported_deactivated"
+
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithIdentity = &UserResource{}
 var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithValidateConfig = &UserResource{}
+var _ resource.ResourceWithModifyPlan = &UserResource{}
 
 type UserResource struct {
 	provider *MetabaseProvider
 }
 
-type UserResourceModel struct {
+type UserModel struct {
 	Id         types.Int64  `tfsdk:"id"`
 	Email      types.String `tfsdk:"email"`
 	FirstName  types.String `tfsdk:"first_name"`
 	LastName   types.String `tfsdk:"last_name"`
 	CommonName types.String `tfsdk:"common_name"`
 	Locale     types.String `tfsdk:"locale"`
-	GroupIds   types.List   `tfsdk:"group_ids"`
+
+	LoginAttributes  types.Map  `tfsdk:"login_attributes"`
+	GroupIds         types.List `tfsdk:"group_ids"`
+	GroupMemberships types.Set  `tfsdk:"group_memberships"`
 
 	GoogleAuth types.Bool `tfsdk:"google_auth"`
 	LdapAuth   types.Bool `tfsdk:"ldap_auth"`
@@ -43,12 +56,26 @@ type UserResourceModel struct {
 	HasInvitedSecondUser    types.Bool `tfsdk:"has_invited_second_user"`
 	HasQuestionAndDashboard types.Bool `tfsdk:"has_question_and_dashboard"`
 
+	SSOSource            types.String `tfsdk:"sso_source"`
+	PersonalCollectionId types.Int64  `tfsdk:"personal_collection_id"`
+
 	DateJoined types.String `tfsdk:"date_joined"`
 	FirstLogin types.String `tfsdk:"first_login"`
 	LastLogin  types.String `tfsdk:"last_login"`
 	UpdatedAt  types.String `tfsdk:"updated_at"`
 }
 
+// UserResourceModel extends the user's details with the options which only control how the resource is managed.
+type UserResourceModel struct {
+	UserModel
+
+	Password          types.String `tfsdk:"password"`
+	PasswordVersion   types.String `tfsdk:"password_version"`
+	SendInvite        types.Bool   `tfsdk:"send_invite"`
+	OnDestroy         types.String `tfsdk:"on_destroy"`
+	ReactivateOnDrift types.Bool   `tfsdk:"reactivate_on_drift"`
+}
+
 type blockTypeUser int
 
 func (u *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -59,6 +86,100 @@ func (u *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 	resp.Schema = schema.UserResource()
 }
 
+func (u *UserResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schema.UserIdentity()
+}
+
+func (u *UserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config UserResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.GroupIds.IsNull() && !config.GroupMemberships.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("group_memberships"),
+			"Invalid attribute combination",
+			"Only one of group_ids or group_memberships can be configured.",
+		)
+	}
+
+	if config.GroupMemberships.IsNull() || config.GroupMemberships.IsUnknown() {
+		return
+	}
+
+	var memberships []UserGroupMembershipModel
+	diags = config.GroupMemberships.ElementsAs(ctx, &memberships, false)
+	resp.Diagnostics.Append(diags...)
+	for _, membership := range memberships {
+		if !membership.GroupId.IsUnknown() && slices.Contains(validators.ReservedGroupIds, membership.GroupId.ValueInt64()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("group_memberships"),
+				"Must not contain reserved group ID",
+				fmt.Sprintf("Config contains reserved group ID %d which must not be explicitly set.", membership.GroupId.ValueInt64()),
+			)
+		}
+	}
+}
+
+// ModifyPlan keeps group_ids and group_memberships in sync, as only one of them can be configured but both are
+// populated from the same memberships.
+func (u *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan, state UserResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	} else {
+		state.GroupIds = types.ListNull(types.Int64Type)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.GroupMemberships.IsNull() {
+		// Derive the group IDs from the memberships, retaining the order they're currently in
+		memberships, diags := fromTerraformGroupMemberships(ctx, plan.GroupMemberships)
+		resp.Diagnostics.Append(diags...)
+		if memberships == nil {
+			plan.GroupIds = types.ListUnknown(types.Int64Type)
+		} else {
+			groupIds := buildGroupIdList(&user.User{GroupMemberships: *memberships}, &state.UserModel)
+			plan.GroupIds = transforms.ToTerraformInt64List(&groupIds)
+		}
+	} else {
+		// Derive the memberships from the group IDs, which don't support group managers
+		groupIds := transforms.FromTerraformInt64List(plan.GroupIds)
+		if plan.GroupIds.IsUnknown() || groupIds == nil || hasUnknownElements(plan.GroupIds.Elements()) {
+			plan.GroupMemberships = types.SetUnknown(schema.UserGroupMembershipType)
+		} else {
+			memberships := make([]user.GroupMembership, len(*groupIds))
+			for i, groupId := range *groupIds {
+				memberships[i] = user.GroupMembership{Id: groupId}
+			}
+			plan.GroupMemberships = toTerraformGroupMemberships(memberships)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("group_ids"), plan.GroupIds)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("group_memberships"), plan.GroupMemberships)...)
+
+	// Deactivated users (eg those which were imported) are reactivated unless reactivate_on_drift is false
+	if !req.State.Raw.IsNull() && !state.IsActive.IsNull() && !state.IsActive.ValueBool() && (plan.ReactivateOnDrift.IsNull() || plan.ReactivateOnDrift.ValueBool()) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("is_active"), types.BoolValue(true))...)
+	}
+}
+
 func (u *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan UserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -67,13 +188,20 @@ func (u *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	groupMemberships := mapToGroupMemberships(transforms.FromTerraformInt64List(plan.GroupIds))
+	groupMemberships, diags := plan.buildGroupMemberships(ctx)
+	resp.Diagnostics.Append(diags...)
+	loginAttributes, diags := fromTerraformLoginAttributes(ctx, plan.LoginAttributes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	userId, err := u.provider.client.User.Create(ctx, &user.CreateRequest{
 		Email:            plan.Email.ValueString(),
 		FirstName:        transforms.FromTerraformString(plan.FirstName),
 		LastName:         transforms.FromTerraformString(plan.LastName),
 		GroupMemberships: groupMemberships,
+		LoginAttributes:  loginAttributes,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -83,19 +211,48 @@ func (u *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	// If the `is_superuser` attribute is set to true we need to update the user
-	if !plan.IsSuperuser.IsNull() && !plan.IsSuperuser.IsUnknown() && plan.IsSuperuser.ValueBool() {
+	// The locale and `is_superuser` attribute can't be set when creating the user, so we need to update the user
+	isSuperuser := !plan.IsSuperuser.IsNull() && !plan.IsSuperuser.IsUnknown() && plan.IsSuperuser.ValueBool()
+	hasLocale := !plan.Locale.IsNull() && !plan.Locale.IsUnknown()
+	if isSuperuser || hasLocale {
 		err := u.provider.client.User.Update(ctx, userId, &user.UpdateRequest{
 			Email:            transforms.FromTerraformString(plan.Email),
 			FirstName:        transforms.FromTerraformString(plan.FirstName),
 			LastName:         transforms.FromTerraformString(plan.LastName),
+			Locale:           transforms.FromTerraformString(plan.Locale),
 			IsSuperuser:      transforms.FromTerraformBool(plan.IsSuperuser),
+			LoginAttributes:  loginAttributes,
 			GroupMemberships: groupMemberships,
 		})
 		if err != nil {
 			resp.Diagnostics.AddWarning(
 				"User partially created",
-				fmt.Sprintf("User with ID %d was created but an error occurred when marking them as a superuser: %s. Try re-applying.", userId, err.Error()),
+				fmt.Sprintf("User with ID %d was created but an error occurred when setting their locale or marking them as a superuser: %s. Try re-applying.", userId, err.Error()),
+			)
+		}
+	}
+
+	// Write-only values are only available in the config
+	var password types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("password"), &password)
+	resp.Diagnostics.Append(diags...)
+	if !password.IsNull() && !password.IsUnknown() {
+		err := u.provider.api.User.SetPassword(ctx, userId, password.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"User partially created",
+				fmt.Sprintf("User with ID %d was created but an error occurred when setting their password: %s. Try changing the password_version.", userId, err.Error()),
+			)
+		}
+	}
+
+	// Metabase already invites the user when they're created, so this only sends the invite again if requested
+	if plan.SendInvite.ValueBool() {
+		err := u.provider.api.User.SendInvite(ctx, userId)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to send invite",
+				fmt.Sprintf("User with ID %d was created but an error occurred when sending their invite: %s", userId, err.Error()),
 			)
 		}
 	}
@@ -103,7 +260,7 @@ func (u *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Refresh the state
 	var userState UserResourceModel
 	userState.Id = types.Int64Value(userId)
-	diags = u.provider.syncUserWithApi(ctx, &userState)
+	diags = u.provider.syncUserWithApi(ctx, &userState.UserModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -115,6 +272,7 @@ func (u *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Update the state
 	diags = resp.State.Set(ctx, userState)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(u.provider.setUserIdentity(ctx, resp.Identity, &userState.UserModel)...)
 }
 
 func (u *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -132,10 +290,35 @@ func (u *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	// Users which were deactivated when imported are left as they are until the next apply
+	importedDeactivated, diags := req.Private.GetKey(ctx, userImportedDeactivatedPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keepDeactivated := !state.ReactivateOnDrift.IsNull() && !state.ReactivateOnDrift.ValueBool()
+
 	userId := state.Id.ValueInt64()
 	usr, err := u.provider.client.User.Get(ctx, userId)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		if strings.Contains(err.Error(), "not found") && (keepDeactivated || len(importedDeactivated) > 0) {
+			// If we shouldn't reactivate the user, check whether they have been deactivated and leave them as they are
+			usr, err = u.provider.findDeactivatedUser(ctx, userId)
+			if err != nil {
+				addUserReadError(userId, err)
+				return
+			} else if usr == nil {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+
+			if keepDeactivated {
+				resp.Diagnostics.AddWarning(
+					fmt.Sprintf("User with ID %d has been deactivated", userId),
+					"The user has been deactivated outside of Terraform, and will not be reactivated as reactivate_on_drift is false.",
+				)
+			}
+		} else if strings.Contains(err.Error(), "not found") {
 			// If the user is not found, attempt to reactivate in case they were manually deactivated
 			err = u.provider.client.User.Reactivate(ctx, userId)
 
@@ -161,9 +344,10 @@ func (u *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 	}
 
-	mapUserToState(usr, &state)
+	mapUserToState(usr, &state.UserModel)
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(u.provider.setUserIdentity(ctx, resp.Identity, &state.UserModel)...)
 }
 
 func (u *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -181,15 +365,37 @@ func (u *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	// Update the user
+	groupMemberships, diags := plan.buildGroupMemberships(ctx)
+	resp.Diagnostics.Append(diags...)
+	loginAttributes, diags := fromTerraformLoginAttributes(ctx, plan.LoginAttributes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reactivate the user first if they're deactivated, which is only planned if reactivate_on_drift allows it
 	userId := state.Id.ValueInt64()
+	if !state.IsActive.IsNull() && !state.IsActive.ValueBool() && plan.IsActive.ValueBool() {
+		err := u.provider.client.User.Reactivate(ctx, userId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error reactivating user with ID %d", userId),
+				fmt.Sprintf("Unexpected error occured: %s", err.Error()),
+			)
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, userImportedDeactivatedPrivateKey, nil)...)
+
+	// Update the user
 	err := u.provider.client.User.Update(ctx, userId, &user.UpdateRequest{
 		Email:            transforms.FromTerraformString(plan.Email),
 		FirstName:        transforms.FromTerraformString(plan.FirstName),
 		LastName:         transforms.FromTerraformString(plan.LastName),
 		Locale:           transforms.FromTerraformString(plan.Locale),
 		IsSuperuser:      transforms.FromTerraformBool(plan.IsSuperuser),
-		GroupMemberships: mapToGroupMemberships(transforms.FromTerraformInt64List(plan.GroupIds)),
+		LoginAttributes:  loginAttributes,
+		GroupMemberships: groupMemberships,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -199,8 +405,37 @@ func (u *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	// Only set the password if the version has changed, as write-only values aren't stored to compare against
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) {
+		var password types.String
+		diags = req.Config.GetAttribute(ctx, path.Root("password"), &password)
+		resp.Diagnostics.Append(diags...)
+		if !password.IsNull() && !password.IsUnknown() {
+			err := u.provider.api.User.SetPassword(ctx, userId, password.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError(
+					fmt.Sprintf("Error setting password for user with ID %d", userId),
+					fmt.Sprintf("Unexpected error occured: %s", err.Error()),
+				)
+				return
+			}
+		}
+	}
+
+	// Send the invite again when send_invite is enabled
+	if plan.SendInvite.ValueBool() && !state.SendInvite.ValueBool() {
+		err := u.provider.api.User.SendInvite(ctx, userId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error sending invite to user with ID %d", userId),
+				fmt.Sprintf("Unexpected error occured: %s", err.Error()),
+			)
+			return
+		}
+	}
+
 	// Refresh the state
-	diags = u.provider.syncUserWithApi(ctx, &state)
+	diags = u.provider.syncUserWithApi(ctx, &state.UserModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -212,6 +447,7 @@ func (u *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Update the state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(u.provider.setUserIdentity(ctx, resp.Identity, &state.UserModel)...)
 }
 
 func (u *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -222,6 +458,11 @@ func (u *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	// Users can't be deleted, so there's nothing to do if we should keep them
+	if userState.OnDestroy.ValueString() == "keep" {
+		return
+	}
+
 	userId := userState.Id.ValueInt64()
 	err := u.provider.client.User.Disable(ctx, userId)
 	if err != nil {
@@ -234,37 +475,98 @@ func (u *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (u *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	userId, _ := strconv.ParseInt(req.ID, 10, 64)
-
-	// TODO: the current approach is a bit hacky as we rely on reactivating the user throwing a 404 and erroring if the
-	//  user doesn't exist, but we can't use client.GetUser() with deactivated users. Maybe it would be better to
-	//  explicitly search using GET /api/user?include_deactivated=true first?
+	userId, diags := u.resolveImportId(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// We'll need to reactivate the user if it exists
-	err := u.provider.client.User.Reactivate(ctx, userId)
+	// Deactivated users aren't returned when fetching them directly, so are imported as they are and only reactivated
+	// on the next apply (depending on reactivate_on_drift)
+	usr, err := u.provider.client.User.Get(ctx, userId)
+	if err != nil && strings.Contains(err.Error(), "not found") {
+		usr, err = u.provider.findDeactivatedUser(ctx, userId)
+		if err == nil && usr == nil {
+			err = fmt.Errorf("user %d not found", userId)
+		}
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error importing user with ID %d", userId),
-			fmt.Sprintf("Error occurred when reactivating user: %s", err.Error()),
+			fmt.Sprintf("An error occurred: %s", err.Error()),
 		)
 		return
 	}
 
-	// Refresh the state from the API
+	if !usr.IsActive {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, userImportedDeactivatedPrivateKey, []byte("true"))...)
+	}
+
 	var state UserResourceModel
 	state.Id = types.Int64Value(userId)
-	diags := u.provider.syncUserWithApi(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	mapUserToState(usr, &state.UserModel)
 
 	// Store the state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(u.provider.setUserIdentity(ctx, resp.Identity, &state.UserModel)...)
 }
 
-func buildGroupIdList(user *user.User, state *UserResourceModel) []int64 {
+// resolveImportId converts the import ID or identity, which can contain either the ID of the user or their email
+// address, into the user ID. Deactivated users are included when searching by email address so that they can be
+// imported.
+func (u *UserResource) resolveImportId(ctx context.Context, req resource.ImportStateRequest) (int64, diag.Diagnostics) {
+	importId := req.ID
+	if isIdentityImport(req) {
+		var identity UserIdentityModel
+		diags := req.Identity.Get(ctx, &identity)
+		diags.Append(u.provider.checkIdentityHost(identity.Host)...)
+		if diags.HasError() {
+			return 0, diags
+		}
+
+		if !identity.Id.IsNull() {
+			return identity.Id.ValueInt64(), diags
+		} else if identity.Email.IsNull() {
+			diags.AddError(
+				"Invalid import identity",
+				"Either the id or email of the user must be provided in the identity.",
+			)
+			return 0, diags
+		}
+		importId = identity.Email.ValueString()
+	} else if !strings.Contains(importId, "@") {
+		return utils.ParseImportId(importId, "the numeric ID or email address of the user")
+	}
+
+	usr, err := u.findUserByEmail(ctx, importId)
+	if err != nil {
+		return 0, diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				fmt.Sprintf("Error importing user %q", importId),
+				fmt.Sprintf("An error occurred: %s", err.Error()),
+			),
+		}
+	}
+
+	return usr.Id, nil
+}
+
+func (u *UserResource) findUserByEmail(ctx context.Context, email string) (*user.User, error) {
+	users, err := u.provider.api.User.List(ctx, &client.ListUsersRequest{
+		Status: "all",
+		Query:  &email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matchUser(users, &UserDataSourceModel{
+		UserModel: UserModel{Email: types.StringValue(email)},
+	})
+}
+
+func buildGroupIdList(user *user.User, state *UserModel) []int64 {
 	var isReservedGroup = func(groupId int64) bool {
 		return slices.Contains(validators.ReservedGroupIds, groupId)
 	}
@@ -299,7 +601,7 @@ func buildGroupIdList(user *user.User, state *UserResourceModel) []int64 {
 	return groupIds
 }
 
-func mapUserToState(user *user.User, target *UserResourceModel) {
+func mapUserToState(user *user.User, target *UserModel) {
 	groupIds := buildGroupIdList(user, target)
 
 	target.Id = types.Int64Value(user.Id)
@@ -315,13 +617,22 @@ func mapUserToState(user *user.User, target *UserResourceModel) {
 	target.IsInstaller = transforms.ToTerraformBool(user.IsInstaller)
 
 	target.GroupIds = transforms.ToTerraformInt64List(&groupIds)
+	target.GroupMemberships = toTerraformGroupMemberships(user.GroupMemberships)
+	target.LoginAttributes = toTerraformLoginAttributes(user.LoginAttributes)
 
 	target.GoogleAuth = types.BoolValue(user.GoogleAuth)
-	// TODO: sso source?
+	target.SSOSource = types.StringNull()
+	if user.SSOSource != nil {
+		target.SSOSource = types.StringValue(string(*user.SSOSource))
+	}
 
 	target.HasInvitedSecondUser = types.BoolValue(user.HasInvitedSecondUser)
 	target.HasQuestionAndDashboard = types.BoolValue(user.HasQuestionAndDashboard)
-	// TODO: personal collection ID?
+	target.PersonalCollectionId = types.Int64Null()
+	if collectionId, ok := user.PersonalCollectionId.(float64); ok {
+		// The SDK doesn't type this, so JSON numbers are unmarshalled as floats
+		target.PersonalCollectionId = types.Int64Value(int64(collectionId))
+	}
 
 	target.DateJoined = types.StringValue(user.DateJoined)
 	target.FirstLogin = transforms.ToTerraformString(user.FirstLogin)
@@ -329,7 +640,7 @@ func mapUserToState(user *user.User, target *UserResourceModel) {
 	target.UpdatedAt = transforms.ToTerraformString(user.UpdatedAt)
 }
 
-func (p *MetabaseProvider) syncUserWithApi(ctx context.Context, state *UserResourceModel) diag.Diagnostics {
+func (p *MetabaseProvider) syncUserWithApi(ctx context.Context, state *UserModel) diag.Diagnostics {
 	userId := state.Id.ValueInt64()
 
 	userDetails, err := p.client.User.Get(ctx, userId)
@@ -346,6 +657,128 @@ func (p *MetabaseProvider) syncUserWithApi(ctx context.Context, state *UserResou
 	return diag.Diagnostics{}
 }
 
+// findDeactivatedUser searches for a deactivated user with the given ID, as the API does not return deactivated users
+// when fetching them directly. This returns nil if there is no deactivated user with the ID.
+func (p *MetabaseProvider) findDeactivatedUser(ctx context.Context, userId int64) (*user.User, error) {
+	users, err := p.api.User.List(ctx, &client.ListUsersRequest{
+		Status: "deactivated",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, usr := range users {
+		if usr.Id == userId {
+			return &usr, nil
+		}
+	}
+
+	return nil, nil
+}
+
+type UserGroupMembershipModel struct {
+	GroupId        types.Int64 `tfsdk:"group_id"`
+	IsGroupManager types.Bool  `tfsdk:"is_group_manager"`
+}
+
+// buildGroupMemberships returns the group memberships to send to the API. The memberships are used if they're known,
+// as they include whether the user is a group manager, otherwise we fall back to the group IDs.
+func (plan *UserResourceModel) buildGroupMemberships(ctx context.Context) (*[]user.GroupMembership, diag.Diagnostics) {
+	memberships, diags := fromTerraformGroupMemberships(ctx, plan.GroupMemberships)
+	if memberships == nil {
+		return mapToGroupMemberships(transforms.FromTerraformInt64List(plan.GroupIds)), diags
+	}
+
+	return memberships, diags
+}
+
+// fromTerraformGroupMemberships converts the group memberships to the API's format, returning nil if they're not fully
+// known. The reserved groups are excluded, as they're managed by Metabase.
+func fromTerraformGroupMemberships(ctx context.Context, set types.Set) (*[]user.GroupMembership, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, nil
+	}
+
+	var models []UserGroupMembershipModel
+	diags := set.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	memberships := make([]user.GroupMembership, 0, len(models))
+	for _, model := range models {
+		if model.GroupId.IsUnknown() || model.IsGroupManager.IsUnknown() {
+			return nil, diags
+		}
+		if !slices.Contains(validators.ReservedGroupIds, model.GroupId.ValueInt64()) {
+			memberships = append(memberships, user.GroupMembership{
+				Id:             model.GroupId.ValueInt64(),
+				IsGroupManager: model.IsGroupManager.ValueBool(),
+			})
+		}
+	}
+
+	return &memberships, diags
+}
+
+func toTerraformGroupMemberships(memberships []user.GroupMembership) types.Set {
+	elements := make([]attr.Value, 0, len(memberships))
+	for _, membership := range memberships {
+		if !slices.Contains(validators.ReservedGroupIds, membership.Id) {
+			elements = append(elements, types.ObjectValueMust(schema.UserGroupMembershipType.AttrTypes, map[string]attr.Value{
+				"group_id":         types.Int64Value(membership.Id),
+				"is_group_manager": types.BoolValue(membership.IsGroupManager),
+			}))
+		}
+	}
+
+	return types.SetValueMust(schema.UserGroupMembershipType, elements)
+}
+
+// fromTerraformLoginAttributes converts the login attributes to the API's format, returning nil if they're unknown so
+// that the existing attributes are left unchanged.
+func fromTerraformLoginAttributes(ctx context.Context, m types.Map) (*user.LoginAttributes, diag.Diagnostics) {
+	if m.IsNull() || m.IsUnknown() {
+		return nil, nil
+	}
+
+	var attributes map[string]string
+	diags := m.ElementsAs(ctx, &attributes, false)
+
+	loginAttributes := make(user.LoginAttributes, len(attributes))
+	for k, v := range attributes {
+		loginAttributes[k] = v
+	}
+	return &loginAttributes, diags
+}
+
+// toTerraformLoginAttributes converts the login attributes from the API. Metabase also allows boolean values, so any
+// non-string values are converted to strings.
+func toTerraformLoginAttributes(loginAttributes *user.LoginAttributes) types.Map {
+	if loginAttributes == nil {
+		return types.MapNull(types.StringType)
+	}
+
+	attributes := make(map[string]attr.Value, len(*loginAttributes))
+	for k, v := range *loginAttributes {
+		if str, ok := v.(string); ok {
+			attributes[k] = types.StringValue(str)
+		} else {
+			attributes[k] = types.StringValue(fmt.Sprint(v))
+		}
+	}
+	return types.MapValueMust(types.StringType, attributes)
+}
+
+func hasUnknownElements(elements []attr.Value) bool {
+	for _, element := range elements {
+		if element.IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
+
 func mapToGroupMemberships(groupIds *[]int64) *[]user.GroupMembership {
 	if groupIds == nil || len(*groupIds) == 0 {
 		return nil
@@ -360,7 +793,7 @@ func mapToGroupMemberships(groupIds *[]int64) *[]user.GroupMembership {
 	return &groupMemberships
 }
 
-func addReservedUserGroups(plan UserResourceModel) *[]int64 {
+func addReservedUserGroups(plan UserModel) *[]int64 {
 	// Add the reserved groups so we don't upset Metabase
 	groupIds := transforms.FromTerraformInt64List(plan.GroupIds)
 	if groupIds != nil {
@@ -375,6 +808,8 @@ func addReservedUserGroups(plan UserResourceModel) *[]int64 {
 }
 
 func (state *UserResourceModel) ensureConsistentCreate(plan *UserResourceModel) {
+	state.ensureConsistentOptions(plan)
+
 	if !plan.Email.IsUnknown() {
 		state.Email = plan.Email
 	}
@@ -387,12 +822,23 @@ func (state *UserResourceModel) ensureConsistentCreate(plan *UserResourceModel)
 	if !plan.GroupIds.IsUnknown() {
 		state.GroupIds = plan.GroupIds
 	}
+	if !plan.GroupMemberships.IsUnknown() {
+		state.GroupMemberships = plan.GroupMemberships
+	}
+	if !plan.LoginAttributes.IsUnknown() {
+		state.LoginAttributes = plan.LoginAttributes
+	}
 	if !plan.IsSuperuser.IsUnknown() {
 		state.IsSuperuser = plan.IsSuperuser
 	}
+	if !plan.Locale.IsUnknown() {
+		state.Locale = plan.Locale
+	}
 }
 
 func (state *UserResourceModel) ensureConsistentUpdate(plan *UserResourceModel) {
+	state.ensureConsistentOptions(plan)
+
 	if !plan.Email.IsUnknown() {
 		state.Email = plan.Email
 	}
@@ -405,6 +851,12 @@ func (state *UserResourceModel) ensureConsistentUpdate(plan *UserResourceModel)
 	if !plan.GroupIds.IsUnknown() {
 		state.GroupIds = plan.GroupIds
 	}
+	if !plan.GroupMemberships.IsUnknown() {
+		state.GroupMemberships = plan.GroupMemberships
+	}
+	if !plan.LoginAttributes.IsUnknown() {
+		state.LoginAttributes = plan.LoginAttributes
+	}
 	if !plan.IsSuperuser.IsUnknown() {
 		state.IsSuperuser = plan.IsSuperuser
 	}
@@ -415,3 +867,13 @@ func (state *UserResourceModel) ensureConsistentUpdate(plan *UserResourceModel)
 		state.IsActive = plan.IsActive
 	}
 }
+
+// ensureConsistentOptions copies the options which only exist in Terraform from the plan, as these can't be read back
+// from the API. The password is write-only, so must always be null.
+func (state *UserResourceModel) ensureConsistentOptions(plan *UserResourceModel) {
+	state.Password = types.StringNull()
+	state.PasswordVersion = plan.PasswordVersion
+	state.SendInvite = plan.SendInvite
+	state.OnDestroy = plan.OnDestroy
+	state.ReactivateOnDrift = plan.ReactivateOnDrift
+}
@@ -1,9 +1,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"github.com/bnjns/metabase-sdk-go/service/user"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"terraform-provider-metabase/internal/schema"
 	"testing"
 )
 
@@ -33,6 +41,18 @@ resource "metabase_user" "test" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "metabase_user.test",
+				ImportState:       true,
+				ImportStateId:     resourceEmail,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "metabase_user.test",
+				ImportState:   true,
+				ImportStateId: "not-an-id",
+				ExpectError:   regexp.MustCompile("Invalid import ID"),
+			},
 		},
 	})
 }
@@ -146,6 +166,326 @@ resource "metabase_user" "test" {
 	})
 }
 
+func TestAccUserResource_Password(t *testing.T) {
+	testAccSkipBelowTerraformVersion(t, "1.11.0")
+	userEmail := testAccRandEmail()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_user" "test" {
+	email            = "%s"
+	password         = "Sup3rS3cretPassw0rd"
+	password_version = "1"
+}
+`, userEmail),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("metabase_user.test", "password"),
+					resource.TestCheckResourceAttr("metabase_user.test", "password_version", "1"),
+					resource.TestCheckNoResourceAttr("metabase_user.test", "sso_source"),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_user" "test" {
+	email            = "%s"
+	password         = "An0therS3cretPassw0rd"
+	password_version = "2"
+}
+`, userEmail),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("metabase_user.test", "password"),
+					resource.TestCheckResourceAttr("metabase_user.test", "password_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserResource_OnDestroyKeep(t *testing.T) {
+	userEmail := testAccRandEmail()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_user" "test" {
+	email      = "%s"
+	on_destroy = "keep"
+}
+`, userEmail),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_user.test", "on_destroy", "keep"),
+					resource.TestCheckResourceAttrSet("metabase_user.test", "personal_collection_id"),
+				),
+			},
+			{
+				// Removing the resource should leave the user active
+				Config: providerConfig + fmt.Sprintf(`
+data "metabase_users" "test" {
+	email = "%s"
+}
+`, userEmail),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.metabase_users.test", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.metabase_users.test", "users.0.is_active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserResource_ImportDeactivated(t *testing.T) {
+	userEmail := testAccRandEmail()
+	userConfig := providerConfig + fmt.Sprintf(`
+resource "metabase_user" "test" {
+	email = "%s"
+}
+`, userEmail)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: userConfig,
+			},
+			{
+				// Removing the resource deactivates the user
+				Config: providerConfig,
+			},
+			{
+				// Importing the user should leave them deactivated
+				Config:             userConfig,
+				ResourceName:       "metabase_user.test",
+				ImportState:        true,
+				ImportStateId:      userEmail,
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported user, got %d", len(states))
+					}
+					if isActive := states[0].Attributes["is_active"]; isActive != "false" {
+						return fmt.Errorf("expected the imported user to be deactivated, got is_active = %s", isActive)
+					}
+					return nil
+				},
+			},
+			{
+				// The next apply should reactivate the user, as reactivate_on_drift defaults to true
+				Config: userConfig,
+				Check:  resource.TestCheckResourceAttr("metabase_user.test", "is_active", "true"),
+			},
+		},
+	})
+}
+
+func TestMapUserToState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("the SSO source and personal collection should be mapped", func(t *testing.T) {
+		ssoSource := user.SSOSourceSAML
+		usr := &user.User{
+			Id:                   2,
+			Email:                "example@example.com",
+			SSOSource:            &ssoSource,
+			PersonalCollectionId: float64(5),
+		}
+		state := UserModel{GroupIds: types.ListNull(types.Int64Type)}
+
+		mapUserToState(usr, &state)
+
+		assert.Equal(t, "saml", state.SSOSource.ValueString())
+		assert.Equal(t, int64(5), state.PersonalCollectionId.ValueInt64())
+	})
+
+	t.Run("a missing SSO source and personal collection should be null", func(t *testing.T) {
+		usr := &user.User{
+			Id:    2,
+			Email: "example@example.com",
+		}
+		state := UserModel{GroupIds: types.ListNull(types.Int64Type)}
+
+		mapUserToState(usr, &state)
+
+		assert.True(t, state.SSOSource.IsNull())
+		assert.True(t, state.PersonalCollectionId.IsNull())
+	})
+}
+
+func TestAccUserResource_LoginAttributesAndLocale(t *testing.T) {
+	userEmail := testAccRandEmail()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_user" "test" {
+	email  = "%s"
+	locale = "en_GB"
+
+	login_attributes = {
+		region = "emea"
+	}
+}
+`, userEmail),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_user.test", "locale", "en_GB"),
+					resource.TestCheckResourceAttr("metabase_user.test", "login_attributes.%", "1"),
+					resource.TestCheckResourceAttr("metabase_user.test", "login_attributes.region", "emea"),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_user" "test" {
+	email  = "%s"
+	locale = "fr"
+
+	login_attributes = {
+		region  = "amer"
+		team_id = "42"
+	}
+}
+`, userEmail),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_user.test", "locale", "fr"),
+					resource.TestCheckResourceAttr("metabase_user.test", "login_attributes.%", "2"),
+					resource.TestCheckResourceAttr("metabase_user.test", "login_attributes.region", "amer"),
+				),
+			},
+			{
+				ResourceName:      "metabase_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccUserResource_GroupMemberships(t *testing.T) {
+	userEmail := testAccRandEmail()
+	groupName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_permissions_group" "test" {
+	name = "%s"
+}
+resource "metabase_user" "test" {
+	email = "%s"
+
+	group_memberships = [
+		{ group_id = metabase_permissions_group.test.id },
+	]
+}
+`, groupName, userEmail),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_user.test", "group_memberships.#", "1"),
+					resource.TestCheckResourceAttr("metabase_user.test", "group_memberships.0.is_group_manager", "false"),
+					resource.TestCheckResourceAttr("metabase_user.test", "group_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("metabase_user.test", "group_ids.0", "metabase_permissions_group.test", "id"),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_permissions_group" "test" {
+	name = "%s"
+}
+resource "metabase_user" "test" {
+	email = "%s"
+}
+`, groupName, userEmail),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_user.test", "group_memberships.#", "0"),
+					resource.TestCheckResourceAttr("metabase_user.test", "group_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestLoginAttributes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("non-string values from the API should be converted to strings", func(t *testing.T) {
+		loginAttributes := user.LoginAttributes{
+			"region":   "emea",
+			"is_admin": true,
+		}
+
+		attributes := toTerraformLoginAttributes(&loginAttributes)
+
+		assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+			"region":   types.StringValue("emea"),
+			"is_admin": types.StringValue("true"),
+		}), attributes)
+	})
+
+	t.Run("missing attributes from the API should be null", func(t *testing.T) {
+		assert.True(t, toTerraformLoginAttributes(nil).IsNull())
+	})
+
+	t.Run("unknown attributes should not be sent to the API", func(t *testing.T) {
+		loginAttributes, diags := fromTerraformLoginAttributes(ctx, types.MapUnknown(types.StringType))
+
+		assert.False(t, diags.HasError())
+		assert.Nil(t, loginAttributes)
+	})
+
+	t.Run("known attributes should be sent to the API", func(t *testing.T) {
+		loginAttributes, diags := fromTerraformLoginAttributes(ctx, types.MapValueMust(types.StringType, map[string]attr.Value{
+			"region": types.StringValue("emea"),
+		}))
+
+		assert.False(t, diags.HasError())
+		if assert.NotNil(t, loginAttributes) {
+			assert.Equal(t, user.LoginAttributes{"region": "emea"}, *loginAttributes)
+		}
+	})
+}
+
+func TestGroupMemberships(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("reserved groups should be excluded", func(t *testing.T) {
+		memberships := toTerraformGroupMemberships([]user.GroupMembership{
+			{Id: 1},
+			{Id: 2},
+			{Id: 3, IsGroupManager: true},
+		})
+
+		if assert.Len(t, memberships.Elements(), 1) {
+			converted, diags := fromTerraformGroupMemberships(ctx, memberships)
+
+			assert.False(t, diags.HasError())
+			if assert.NotNil(t, converted) {
+				assert.Equal(t, []user.GroupMembership{{Id: 3, IsGroupManager: true}}, *converted)
+			}
+		}
+	})
+
+	t.Run("the group IDs should be used if the memberships are unknown", func(t *testing.T) {
+		plan := UserResourceModel{}
+		plan.GroupMemberships = types.SetUnknown(schema.UserGroupMembershipType)
+		plan.GroupIds = types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(4)})
+
+		memberships, diags := plan.buildGroupMemberships(ctx)
+
+		assert.False(t, diags.HasError())
+		if assert.NotNil(t, memberships) {
+			assert.Equal(t, []user.GroupMembership{{Id: 4}}, *memberships)
+		}
+	})
+}
+
 func testAccRandEmail() string {
 	return fmt.Sprintf("%s@example.com", acctest.RandString(8))
 }
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/bnjns/metabase-sdk-go/service/user"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+	"strings"
+	"terraform-provider-metabase/internal/client"
+	"terraform-provider-metabase/internal/schema"
+	"terraform-provider-metabase/internal/transforms"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &UsersDataSource{}
+
+type UsersDataSource struct {
+	provider *MetabaseProvider
+}
+
+type UsersDataSourceModel struct {
+	Email       types.String `tfsdk:"email"`
+	EmailDomain types.String `tfsdk:"email_domain"`
+	GroupId     types.Int64  `tfsdk:"group_id"`
+	Status      types.String `tfsdk:"status"`
+	SSOSource   types.String `tfsdk:"sso_source"`
+
+	Users []UserModel `tfsdk:"users"`
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.UsersDataSource()
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API can only search by a partial match, so we narrow down the results as much as possible and then filter
+	// them exactly ourselves
+	var query *string
+	if !data.Email.IsNull() {
+		query = transforms.FromTerraformString(data.Email)
+	} else if !data.EmailDomain.IsNull() {
+		domainQuery := "@" + data.EmailDomain.ValueString()
+		query = &domainQuery
+	}
+
+	users, err := d.provider.api.User.List(ctx, &client.ListUsersRequest{
+		Status:  data.Status.ValueString(),
+		Query:   query,
+		GroupId: transforms.FromTerraformInt(data.GroupId),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list users",
+			fmt.Sprintf("An error occurred: %s", err.Error()),
+		)
+		return
+	}
+
+	filtered := filterUsers(users, &data)
+	data.Users = make([]UserModel, len(filtered))
+	for i, usr := range filtered {
+		mapUserToState(&usr, &data.Users[i])
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// filterUsers applies the exact email, email domain and SSO source filters to the users returned by the API, and
+// sorts them by ID so the result is stable.
+func filterUsers(users []user.User, filters *UsersDataSourceModel) []user.User {
+	filtered := make([]user.User, 0, len(users))
+	for _, usr := range users {
+		email := strings.ToLower(usr.Email)
+
+		if !filters.Email.IsNull() && email != strings.ToLower(filters.Email.ValueString()) {
+			continue
+		}
+		if !filters.EmailDomain.IsNull() && !strings.HasSuffix(email, "@"+strings.ToLower(filters.EmailDomain.ValueString())) {
+			continue
+		}
+		if !filters.SSOSource.IsNull() && (usr.SSOSource == nil || string(*usr.SSOSource) != filters.SSOSource.ValueString()) {
+			continue
+		}
+
+		filtered = append(filtered, usr)
+	}
+
+	slices.SortFunc(filtered, func(a, b user.User) bool {
+		return a.Id < b.Id
+	})
+
+	return filtered
+}
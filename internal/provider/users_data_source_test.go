@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/bnjns/metabase-sdk-go/service/user"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFilterUsers(t *testing.T) {
+	t.Parallel()
+
+	saml := user.SSOSourceSAML
+	users := []user.User{
+		{Id: 3, Email: "sso@example.com", SSOSource: &saml},
+		{Id: 1, Email: "Example@Example.com"},
+		{Id: 2, Email: "other@example.org"},
+	}
+
+	noFilters := UsersDataSourceModel{
+		Email:       types.StringNull(),
+		EmailDomain: types.StringNull(),
+		SSOSource:   types.StringNull(),
+	}
+
+	userIds := func(users []user.User) []int64 {
+		ids := make([]int64, len(users))
+		for i, usr := range users {
+			ids[i] = usr.Id
+		}
+		return ids
+	}
+
+	t.Run("no filters should include all users sorted by ID", func(t *testing.T) {
+		assert.Equal(t, []int64{1, 2, 3}, userIds(filterUsers(users, &noFilters)))
+	})
+
+	t.Run("email filter should be an exact case-insensitive match", func(t *testing.T) {
+		filters := noFilters
+		filters.Email = types.StringValue("example@example.COM")
+
+		assert.Equal(t, []int64{1}, userIds(filterUsers(users, &filters)))
+	})
+
+	t.Run("email domain filter should only match the whole domain", func(t *testing.T) {
+		filters := noFilters
+		filters.EmailDomain = types.StringValue("example.com")
+
+		assert.Equal(t, []int64{1, 3}, userIds(filterUsers(users, &filters)))
+	})
+
+	t.Run("SSO source filter should exclude users without a source", func(t *testing.T) {
+		filters := noFilters
+		filters.SSOSource = types.StringValue("saml")
+
+		assert.Equal(t, []int64{3}, userIds(filterUsers(users, &filters)))
+	})
+}
+
+func TestAccUsersDataSource_Email(t *testing.T) {
+	userEmail := testAccRandEmail()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "metabase_user" "test" {
+	email      = "%s"
+	first_name = "%s"
+	last_name  = "%s"
+}
+
+data "metabase_users" "test" {
+	email = metabase_user.test.email
+}
+`, userEmail, testAccUserFirstName, testAccUserLastName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.metabase_users.test", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.metabase_users.test", "users.0.id", "metabase_user.test", "id"),
+					resource.TestCheckResourceAttr("data.metabase_users.test", "users.0.email", userEmail),
+					resource.TestCheckResourceAttr("data.metabase_users.test", "users.0.is_active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUsersDataSource_Status(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "metabase_users" "test" {
+	email_domain = "example.com"
+	status       = "all"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.metabase_users.test", "users.*", map[string]string{
+						"email":        "example@example.com",
+						"is_superuser": "true",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,107 @@
+package schema
+
+import (
+	rSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"terraform-provider-metabase/internal/modifiers"
+	"terraform-provider-metabase/internal/validators"
+)
+
+var ActionTypes = []string{"implicit", "query", "http"}
+var ActionKinds = []string{"row/create", "row/update", "row/delete", "bulk/create", "bulk/update", "bulk/delete"}
+
+func ActionResource() rSchema.Schema {
+	return rSchema.Schema{
+		Description:         "Allows for creating and managing actions on models, which let users write data back to the model's database. Actions can only be run once they have been enabled for the model's database, using the database's actions_enabled attribute.",
+		MarkdownDescription: "Allows for creating and managing actions on models, which let users write data back to the model's database. Actions can only be run once they have been enabled for the model's database, using the `actions_enabled` attribute of `metabase_database`.",
+		Attributes: map[string]rSchema.Attribute{
+			"id": rSchema.Int64Attribute{
+				Description: "The ID of the action.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"model_id": rSchema.Int64Attribute{
+				Description: "The ID of the model (card) the action belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": rSchema.StringAttribute{
+				Description: "The name of the action.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"description": rSchema.StringAttribute{
+				Description: "A description of the action.",
+				Optional:    true,
+			},
+			"type": rSchema.StringAttribute{
+				Description:         "The type of action. Use implicit to create, update or delete rows in the model's table, query to run a custom native query or http to call an external endpoint.",
+				MarkdownDescription: "The type of action. Use `implicit` to create, update or delete rows in the model's table, `query` to run a custom native query or `http` to call an external endpoint.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.OneOfStringValidator(ActionTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kind": rSchema.StringAttribute{
+				Description:         "What an implicit action does to the model's table. Must be one of row/create, row/update, row/delete, bulk/create, bulk/update or bulk/delete. Required for implicit actions.",
+				MarkdownDescription: "What an implicit action does to the model's table. Must be one of `row/create`, `row/update`, `row/delete`, `bulk/create`, `bulk/update` or `bulk/delete`. Required for `implicit` actions.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.OneOfStringValidator(ActionKinds...),
+				},
+			},
+			"dataset_query": rSchema.StringAttribute{
+				Description:         "Serialised JSON string containing the native query run by a query action, which must include the ID of the database. Required for query actions.",
+				MarkdownDescription: "Serialised JSON string containing the native query run by a query action, which must include the ID of the `database`. Required for `query` actions.",
+				Optional:            true,
+			},
+			"template": rSchema.StringAttribute{
+				Description:         "Serialised JSON string containing the request made by an HTTP action. Required for http actions.",
+				MarkdownDescription: "Serialised JSON string containing the request made by an HTTP action. Required for `http` actions.",
+				Optional:            true,
+			},
+			"parameters": rSchema.StringAttribute{
+				Description: "Serialised JSON list of the action's parameters. For query actions, there should be a parameter for each template tag. When not provided for an implicit action, Metabase generates the parameters from the model's columns.",
+				Optional:    true,
+			},
+			"visualization_settings": rSchema.StringAttribute{
+				Description: "Serialised JSON string containing the settings for the action's form, such as the field types, labels and ordering.",
+				Optional:    true,
+			},
+			"archived": rSchema.BoolAttribute{
+				Description: "Whether the action is archived, which prevents it from being run. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultToFalseModifier(),
+				},
+			},
+			"creator_id": rSchema.Int64Attribute{
+				Description: "The ID of the user who created the action.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": rSchema.StringAttribute{
+				Description: "The timestamp of when the action was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
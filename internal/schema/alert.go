@@ -0,0 +1,73 @@
+package schema
+
+import (
+	rSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"terraform-provider-metabase/internal/modifiers"
+	"terraform-provider-metabase/internal/validators"
+)
+
+var AlertConditions = []string{"rows", "goal"}
+
+func AlertResource() rSchema.Schema {
+	return rSchema.Schema{
+		Description: "Allows for creating and managing alerts on questions, which notify email recipients and/or Slack when the question has results or reaches its goal. Alerts are archived when destroyed, as Metabase does not support deleting them.",
+		Attributes: map[string]rSchema.Attribute{
+			"id": rSchema.Int64Attribute{
+				Description: "The ID of the alert.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"card_id": rSchema.Int64Attribute{
+				Description: "The ID of the question (card) the alert is for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"alert_condition": rSchema.StringAttribute{
+				Description:         "When the alert is sent. Use rows to send the alert whenever the question has results, or goal to send it when the question's results cross its goal line. Goal alerts are only supported by line, area, bar and progress questions with a goal.",
+				MarkdownDescription: "When the alert is sent. Use `rows` to send the alert whenever the question has results, or `goal` to send it when the question's results cross its goal line. Goal alerts are only supported by line, area, bar and progress questions with a goal.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.OneOfStringValidator(AlertConditions...),
+				},
+			},
+			"alert_first_only": rSchema.BoolAttribute{
+				Description: "Whether to only send the alert the first time the condition is met, after which it is archived. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultToFalseModifier(),
+				},
+			},
+			"alert_above_goal": rSchema.BoolAttribute{
+				Description:         "Whether to send the alert when the results go above the goal, rather than below it. Required when the alert_condition is goal.",
+				MarkdownDescription: "Whether to send the alert when the results go above the goal, rather than below it. Required when the `alert_condition` is `goal`.",
+				Optional:            true,
+			},
+			"email":    notificationEmailChannelAttribute(),
+			"slack":    notificationSlackChannelAttribute(),
+			"schedule": notificationScheduleAttribute(),
+			"creator_id": rSchema.Int64Attribute{
+				Description: "The ID of the user who created the alert.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": rSchema.StringAttribute{
+				Description: "The timestamp of when the alert was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	lSchema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	rSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/validators"
+)
+
+func ApiKeyResource() rSchema.Schema {
+	return rSchema.Schema{
+		Description:         "Allows for creating and managing API keys in Metabase. The unmasked key is only available when the key is created or regenerated, so is not populated when importing.",
+		MarkdownDescription: "Allows for creating and managing API keys in Metabase. The unmasked `key` is only available when the key is created or regenerated, so is not populated when importing.",
+		Attributes: map[string]rSchema.Attribute{
+			"id": rSchema.Int64Attribute{
+				Description: "The ID of the API key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": rSchema.StringAttribute{
+				Description: "The name of the API key.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"group_id": rSchema.Int64Attribute{
+				Description: "The ID of the permissions group the API key belongs to. The key will have the permissions of this group.",
+				Required:    true,
+			},
+			"rotation_triggers": rSchema.MapAttribute{
+				ElementType:         types.StringType,
+				Description:         "Arbitrary map of values which, when changed, will cause the key to be regenerated.",
+				MarkdownDescription: "Arbitrary map of values which, when changed, will cause the `key` to be regenerated.",
+				Optional:            true,
+			},
+			"key": rSchema.StringAttribute{
+				Description: "The unmasked API key. This is only available when the key is created or regenerated.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"masked_key": rSchema.StringAttribute{
+				Description: "The masked API key, which can be used to identify the key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": rSchema.StringAttribute{
+				Description: "The timestamp of when the API key was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": rSchema.StringAttribute{
+				Description: "The timestamp of when the API key was last updated.",
+				Computed:    true,
+			},
+			"updated_by_id": rSchema.Int64Attribute{
+				Description: "The ID of the user who last updated the API key.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func ApiKeyListResource() lSchema.Schema {
+	return lSchema.Schema{
+		Description: "Lists the API keys in Metabase, so that they can be imported using terraform query.",
+		Attributes:  map[string]lSchema.Attribute{},
+	}
+}
@@ -0,0 +1,109 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	rSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/modifiers"
+	"terraform-provider-metabase/internal/validators"
+)
+
+var DashboardSubscriptionCardType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"card_id":           types.Int64Type,
+		"dashboard_card_id": types.Int64Type,
+		"include_csv":       types.BoolType,
+		"include_xls":       types.BoolType,
+	},
+}
+
+func DashboardSubscriptionResource() rSchema.Schema {
+	return rSchema.Schema{
+		Description: "Allows for creating and managing dashboard subscriptions, which send the cards of a dashboard to email recipients and/or Slack on a schedule. Subscriptions are archived when destroyed, as Metabase does not support deleting them.",
+		Attributes: map[string]rSchema.Attribute{
+			"id": rSchema.Int64Attribute{
+				Description: "The ID of the subscription.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"dashboard_id": rSchema.Int64Attribute{
+				Description: "The ID of the dashboard the subscription sends.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": rSchema.StringAttribute{
+				Description: "The name of the subscription, which is used as the subject of any emails. This is usually the name of the dashboard.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"cards": rSchema.ListNestedAttribute{
+				Description: "The cards of the dashboard to include in the subscription, in the order they are sent.",
+				Required:    true,
+				NestedObject: rSchema.NestedAttributeObject{
+					Attributes: map[string]rSchema.Attribute{
+						"card_id": rSchema.Int64Attribute{
+							Description: "The ID of the card.",
+							Required:    true,
+						},
+						"dashboard_card_id": rSchema.Int64Attribute{
+							Description: "The ID of the card's position on the dashboard (the dashcard).",
+							Required:    true,
+						},
+						"include_csv": rSchema.BoolAttribute{
+							Description: "Whether to attach the card's results as a CSV file. Defaults to false.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"include_xls": rSchema.BoolAttribute{
+							Description: "Whether to attach the card's results as an XLSX file. Defaults to false.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"email":    notificationEmailChannelAttribute(),
+			"slack":    notificationSlackChannelAttribute(),
+			"schedule": notificationScheduleAttribute(),
+			"skip_if_empty": rSchema.BoolAttribute{
+				Description: "Whether to skip sending the subscription when none of the cards have any results. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultToFalseModifier(),
+				},
+			},
+			"parameters": rSchema.StringAttribute{
+				Description: "Serialised JSON list of the dashboard filter values to use instead of the dashboard's defaults. Each entry must contain the id of the dashboard parameter and the value to use.",
+				Optional:    true,
+			},
+			"creator_id": rSchema.Int64Attribute{
+				Description: "The ID of the user who created the subscription.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": rSchema.StringAttribute{
+				Description: "The timestamp of when the subscription was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
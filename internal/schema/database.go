@@ -3,7 +3,9 @@ package schema
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	dSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	lSchema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	rSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -77,25 +79,44 @@ func DatabaseResource() rSchema.Schema {
 				Description:    "The schedules used to sync the database.",
 				Computed:       true,
 			},
+			"actions_enabled": rSchema.BoolAttribute{
+				Description: "Whether actions can be run against the database. The database engine must support actions. If not configured, the existing setting is left unchanged.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
 
 func DatabaseDataSource() dSchema.Schema {
 	return dSchema.Schema{
-		Description: "Gets the details of the provided database.",
+		Description: "Gets the details of the provided database, which can be looked up by either its ID or name.",
 		Attributes: map[string]dSchema.Attribute{
 			"id": dSchema.Int64Attribute{
-				Description: "The ID of the database.",
-				Required:    true,
+				Description:         "The ID of the database. Exactly one of id or name must be provided.",
+				MarkdownDescription: "The ID of the database. Exactly one of `id` or `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"engine": dSchema.StringAttribute{
-				Description: "The engine type of the database.",
+				Description: "The engine type of the database. When looking up the database by name, this can be provided to only match databases using this engine.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
 			},
 			"name": dSchema.StringAttribute{
-				Description: "The name of the database.",
-				Computed:    true,
+				Description:         "The name of the database. Exactly one of id or name must be provided.",
+				MarkdownDescription: "The name of the database. Exactly one of `id` or `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
 			},
 			"features": dSchema.ListAttribute{
 				ElementType: types.StringType,
@@ -114,3 +135,81 @@ func DatabaseDataSource() dSchema.Schema {
 		},
 	}
 }
+
+func DatabasesDataSource() dSchema.Schema {
+	return dSchema.Schema{
+		Description: "Gets the details of all databases, including the sample and audit databases.",
+		Attributes: map[string]dSchema.Attribute{
+			"databases": dSchema.ListNestedAttribute{
+				Description: "The databases, ordered by ID.",
+				Computed:    true,
+				NestedObject: dSchema.NestedAttributeObject{
+					Attributes: map[string]dSchema.Attribute{
+						"id": dSchema.Int64Attribute{
+							Description: "The ID of the database.",
+							Computed:    true,
+						},
+						"engine": dSchema.StringAttribute{
+							Description: "The engine type of the database.",
+							Computed:    true,
+						},
+						"name": dSchema.StringAttribute{
+							Description: "The name of the database.",
+							Computed:    true,
+						},
+						"features": dSchema.ListAttribute{
+							ElementType: types.StringType,
+							Description: "The features this database engine supports.",
+							Computed:    true,
+						},
+						"details": dSchema.StringAttribute{
+							Description: "Serialised JSON string containing the configuration options for the database. This will not contain any sensitive/redacted properties.",
+							Computed:    true,
+						},
+						"schedules": dSchema.ObjectAttribute{
+							AttributeTypes: DatabaseSchedulesType.AttributeTypes(),
+							Description:    "The schedules used to sync the database.",
+							Computed:       true,
+						},
+						"is_sample": dSchema.BoolAttribute{
+							Description: "Whether this is the sample database which ships with Metabase.",
+							Computed:    true,
+						},
+						"is_audit": dSchema.BoolAttribute{
+							Description: "Whether this is the internal audit (Usage analytics) database.",
+							Computed:    true,
+						},
+						"initial_sync_status": dSchema.StringAttribute{
+							Description:         "The status of the database's initial sync. One of incomplete, complete or aborted.",
+							MarkdownDescription: "The status of the database's initial sync. One of `incomplete`, `complete` or `aborted`.",
+							Computed:            true,
+						},
+						"is_syncing": dSchema.BoolAttribute{
+							Description: "Whether the database's initial sync is still in progress.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DatabaseListResource() lSchema.Schema {
+	return lSchema.Schema{
+		Description: "Lists the databases in Metabase, so that they can be imported using terraform query. The internal audit database cannot be managed, so is not included.",
+		Attributes: map[string]lSchema.Attribute{
+			"engine": lSchema.StringAttribute{
+				Description: "Only include databases using this engine.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"include_sample": lSchema.BoolAttribute{
+				Description: "Whether to include the sample database. Defaults to false.",
+				Optional:    true,
+			},
+		},
+	}
+}
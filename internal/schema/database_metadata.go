@@ -0,0 +1,91 @@
+package schema
+
+import (
+	dSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func DatabaseMetadataDataSource() dSchema.Schema {
+	return dSchema.Schema{
+		Description: "Gets the schemas, tables and fields of the provided database, which can be used to look up their IDs by name.",
+		Attributes: map[string]dSchema.Attribute{
+			"database_id": dSchema.Int64Attribute{
+				Description: "The ID of the database.",
+				Required:    true,
+			},
+			"schema": dSchema.StringAttribute{
+				Description: "Only include tables in this schema.",
+				Optional:    true,
+			},
+			"table_name": dSchema.StringAttribute{
+				Description: "Only include tables with this name.",
+				Optional:    true,
+			},
+			"schemas": dSchema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "The names of the schemas which contain the included tables.",
+				Computed:    true,
+			},
+			"tables": dSchema.ListNestedAttribute{
+				Description: "The tables in the database.",
+				Computed:    true,
+				NestedObject: dSchema.NestedAttributeObject{
+					Attributes: map[string]dSchema.Attribute{
+						"id": dSchema.Int64Attribute{
+							Description: "The ID of the table.",
+							Computed:    true,
+						},
+						"schema": dSchema.StringAttribute{
+							Description: "The schema the table belongs to.",
+							Computed:    true,
+						},
+						"name": dSchema.StringAttribute{
+							Description: "The name of the table in the database.",
+							Computed:    true,
+						},
+						"display_name": dSchema.StringAttribute{
+							Description: "The name of the table displayed in Metabase.",
+							Computed:    true,
+						},
+						"visibility_type": dSchema.StringAttribute{
+							Description: "Whether the table is hidden from users, and why.",
+							Computed:    true,
+						},
+						"fields": dSchema.ListNestedAttribute{
+							Description: "The fields in the table.",
+							Computed:    true,
+							NestedObject: dSchema.NestedAttributeObject{
+								Attributes: map[string]dSchema.Attribute{
+									"id": dSchema.Int64Attribute{
+										Description: "The ID of the field.",
+										Computed:    true,
+									},
+									"name": dSchema.StringAttribute{
+										Description: "The name of the column in the database.",
+										Computed:    true,
+									},
+									"display_name": dSchema.StringAttribute{
+										Description: "The name of the field displayed in Metabase.",
+										Computed:    true,
+									},
+									"base_type": dSchema.StringAttribute{
+										Description: "The type of the field, as determined by the sync.",
+										Computed:    true,
+									},
+									"semantic_type": dSchema.StringAttribute{
+										Description: "The semantic type of the field.",
+										Computed:    true,
+									},
+									"fk_target_field_id": dSchema.Int64Attribute{
+										Description: "The ID of the field this field references, if it is a foreign key.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
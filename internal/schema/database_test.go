@@ -20,7 +20,7 @@ func TestDatabaseResource(t *testing.T) {
 		resourceSchema := DatabaseResource()
 
 		assert.NotEmpty(t, resourceSchema.Description)
-		assert.Equal(t, 7, len(resourceSchema.Attributes))
+		assert.Equal(t, 8, len(resourceSchema.Attributes))
 
 		t.Run("id should be configured", func(t *testing.T) {
 			assert.IsType(t, rSchema.Int64Attribute{}, resourceSchema.Attributes["id"])
@@ -88,6 +88,17 @@ func TestDatabaseResource(t *testing.T) {
 			assert.False(t, schedules.IsRequired())
 			assert.False(t, schedules.IsOptional())
 		})
+
+		t.Run("actions_enabled should be configured", func(t *testing.T) {
+			assert.IsType(t, rSchema.BoolAttribute{}, resourceSchema.Attributes["actions_enabled"])
+
+			actionsEnabled := resourceSchema.Attributes["actions_enabled"].(rSchema.BoolAttribute)
+			assert.NotEmpty(t, actionsEnabled.Description)
+			assert.True(t, actionsEnabled.IsOptional())
+			assert.True(t, actionsEnabled.IsComputed())
+			assert.Nil(t, actionsEnabled.BoolDefaultValue())
+			assert.Len(t, actionsEnabled.BoolPlanModifiers(), 1)
+		})
 	})
 }
 
@@ -105,7 +116,8 @@ func TestDatabaseDataSource(t *testing.T) {
 
 			id := dataSourceSchema.Attributes["id"].(dSchema.Int64Attribute)
 			assert.NotEmpty(t, id.Description)
-			assert.True(t, id.IsRequired())
+			assert.True(t, id.IsOptional())
+			assert.True(t, id.IsComputed())
 		})
 
 		t.Run("engine should be configured", func(t *testing.T) {
@@ -113,6 +125,7 @@ func TestDatabaseDataSource(t *testing.T) {
 
 			engine := dataSourceSchema.Attributes["engine"].(dSchema.StringAttribute)
 			assert.NotEmpty(t, engine.Description)
+			assert.True(t, engine.IsOptional())
 			assert.True(t, engine.IsComputed())
 		})
 
@@ -121,6 +134,7 @@ func TestDatabaseDataSource(t *testing.T) {
 
 			name := dataSourceSchema.Attributes["name"].(dSchema.StringAttribute)
 			assert.NotEmpty(t, name.Description)
+			assert.True(t, name.IsOptional())
 			assert.True(t, name.IsComputed())
 		})
 
@@ -149,3 +163,28 @@ func TestDatabaseDataSource(t *testing.T) {
 		})
 	})
 }
+
+func TestDatabasesDataSource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("databases schema should return expected fields", func(t *testing.T) {
+		dataSourceSchema := DatabasesDataSource()
+
+		assert.NotEmpty(t, dataSourceSchema.Description)
+		assert.Equal(t, 1, len(dataSourceSchema.Attributes))
+
+		t.Run("databases should be configured", func(t *testing.T) {
+			assert.IsType(t, dSchema.ListNestedAttribute{}, dataSourceSchema.Attributes["databases"])
+
+			databases := dataSourceSchema.Attributes["databases"].(dSchema.ListNestedAttribute)
+			assert.NotEmpty(t, databases.Description)
+			assert.True(t, databases.IsComputed())
+
+			for name, attribute := range databases.NestedObject.Attributes {
+				assert.Truef(t, attribute.IsComputed(), "%s should be computed", name)
+				assert.Falsef(t, attribute.IsOptional(), "%s should not be configurable", name)
+				assert.NotEmptyf(t, attribute.GetDescription(), "%s should have a description", name)
+			}
+		})
+	})
+}
@@ -0,0 +1,168 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	lSchema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	rSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/validators"
+)
+
+var FieldVisibilityTypes = []string{"normal", "details-only", "sensitive", "retired", "hidden"}
+var FieldHasFieldValues = []string{"none", "list", "search", "auto-list"}
+var FieldDimensionTypes = []string{"internal", "external"}
+
+var FieldDimensionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":                    types.StringType,
+		"name":                    types.StringType,
+		"human_readable_field_id": types.Int64Type,
+	},
+}
+
+func FieldResource() rSchema.Schema {
+	return rSchema.Schema{
+		Description: "Allows for managing the metadata of a field (column) which has been synced from a database.",
+		Attributes: map[string]rSchema.Attribute{
+			"id": rSchema.Int64Attribute{
+				Description: "The ID of the field.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"table_id": rSchema.Int64Attribute{
+				Description: "The ID of the table the field belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": rSchema.StringAttribute{
+				Description: "The name of the column in the database.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"base_type": rSchema.StringAttribute{
+				Description: "The type of the field, as determined by the sync.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": rSchema.StringAttribute{
+				Description: "The name of the field displayed in Metabase.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": rSchema.StringAttribute{
+				Description: "The description of the field.",
+				Optional:    true,
+			},
+			"semantic_type": rSchema.StringAttribute{
+				Description:         "The semantic type of the field (eg, type/PK, type/FK, type/Email). Defaults to the type inferred by the sync.",
+				MarkdownDescription: "The semantic type of the field (eg, `type/PK`, `type/FK`, `type/Email`). Defaults to the type inferred by the sync.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fk_target_field_id": rSchema.Int64Attribute{
+				Description:         "The ID of the field this field references, if it is a foreign key (semantic type type/FK).",
+				MarkdownDescription: "The ID of the field this field references, if it is a foreign key (semantic type `type/FK`).",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"visibility_type": rSchema.StringAttribute{
+				Description:         "Where the field is visible. Must be one of normal, details-only, sensitive, retired or hidden.",
+				MarkdownDescription: "Where the field is visible. Must be one of `normal`, `details-only`, `sensitive`, `retired` or `hidden`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					validators.OneOfStringValidator(FieldVisibilityTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"has_field_values": rSchema.StringAttribute{
+				Description:         "How users filter on this field. Must be one of none, list, search or auto-list.",
+				MarkdownDescription: "How users filter on this field. Must be one of `none`, `list`, `search` or `auto-list`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					validators.OneOfStringValidator(FieldHasFieldValues...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"coercion_strategy": rSchema.StringAttribute{
+				Description:         "The strategy used to cast the field's values to another type (eg, Coercion/UNIXSeconds->DateTime).",
+				MarkdownDescription: "The strategy used to cast the field's values to another type (eg, `Coercion/UNIXSeconds->DateTime`).",
+				Optional:            true,
+			},
+			"settings": rSchema.StringAttribute{
+				Description: "Serialised JSON string containing the field's formatting settings.",
+				Optional:    true,
+			},
+			"dimension": rSchema.SingleNestedAttribute{
+				Description: "How the field's values are remapped when displayed.",
+				Optional:    true,
+				Attributes: map[string]rSchema.Attribute{
+					"type": rSchema.StringAttribute{
+						Description:         "The type of remapping. Use internal to display custom values (which must be configured in Metabase), or external to display the values of another field via a foreign key.",
+						MarkdownDescription: "The type of remapping. Use `internal` to display custom values (which must be configured in Metabase), or `external` to display the values of another field via a foreign key.",
+						Required:            true,
+						Validators: []validator.String{
+							validators.OneOfStringValidator(FieldDimensionTypes...),
+						},
+					},
+					"name": rSchema.StringAttribute{
+						Description: "The name of the remapped field displayed in Metabase.",
+						Required:    true,
+						Validators: []validator.String{
+							validators.NotEmptyStringValidator(),
+						},
+					},
+					"human_readable_field_id": rSchema.Int64Attribute{
+						Description:         "The ID of the field to display instead. Required when the type is external.",
+						MarkdownDescription: "The ID of the field to display instead. Required when the `type` is `external`.",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func FieldListResource() lSchema.Schema {
+	return lSchema.Schema{
+		Description: "Lists the fields of a table which has been synced into Metabase, so that their metadata can be imported using terraform query.",
+		Attributes: map[string]lSchema.Attribute{
+			"table_id": lSchema.Int64Attribute{
+				Description: "The ID of the table to list the fields of.",
+				Required:    true,
+			},
+		},
+	}
+}
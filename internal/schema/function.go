@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var EmbedResourceTypes = []string{"dashboard", "question"}
+
+func SignedEmbedUrlFunction() function.Definition {
+	return function.Definition{
+		Summary:             "Creates a signed static embedding URL",
+		Description:         "Creates the signed JWT and URL path used to embed a dashboard or question in another application, in the same way as Metabase's embedding code samples. The URL is relative to the site URL of the Metabase instance, eg /embed/dashboard/<token>#bordered=true&titled=true, so should be prefixed with the site URL. The URL is created without calling the Metabase API.",
+		MarkdownDescription: "Creates the signed JWT and URL path used to embed a dashboard or question in another application, in the same way as Metabase's embedding code samples. The URL is relative to the site URL of the Metabase instance, eg `/embed/dashboard/<token>#bordered=true&titled=true`, so should be prefixed with the site URL. The URL is created without calling the Metabase API.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "The type of resource being embedded. Must be either dashboard or question.",
+				MarkdownDescription: "The type of resource being embedded. Must be either `dashboard` or `question`.",
+			},
+			function.Int64Parameter{
+				Name:        "id",
+				Description: "The ID of the dashboard or question being embedded.",
+			},
+			function.DynamicParameter{
+				Name:           "params",
+				Description:    "An object containing the values of the locked parameters, keyed by their slug. Can be null if there are no locked parameters.",
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:                "secret",
+				Description:         "The embedding secret key used to sign the JWT.",
+				MarkdownDescription: "The embedding secret key used to sign the JWT, which should match the `embedding_secret_key` configured in Metabase.",
+			},
+			function.Int64Parameter{
+				Name:           "exp",
+				Description:    "When the JWT expires, as the number of seconds since the Unix epoch. Can be null if the JWT should not expire.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func MbqlFieldRefFunction() function.Definition {
+	return function.Definition{
+		Summary:             "Creates an MBQL reference to a field",
+		Description:         "Creates the MBQL reference to a field, eg [\"field\", 1, null], which can be used in queries and the dimensions of template tags. The reference is a tuple, so can be passed to the native_query function or jsonencode without being decoded.",
+		MarkdownDescription: "Creates the MBQL reference to a field, eg `[\"field\", 1, null]`, which can be used in queries and the dimensions of template tags. The reference is a tuple, so can be passed to the `native_query` function or `jsonencode` without being decoded.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "field_id",
+				Description: "The ID of the field.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func NativeQueryFunction() function.Definition {
+	return function.Definition{
+		Summary:             "Creates a native query",
+		Description:         "Creates the JSON-encoded dataset query for a native (eg SQL) query against a database. The JSON is normalised in the same way as the resources, so can be compared with the values read from Metabase.",
+		MarkdownDescription: "Creates the JSON-encoded `dataset_query` for a native (eg SQL) query against a database. The JSON is normalised in the same way as the resources, so can be compared with the values read from Metabase.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "database_id",
+				Description: "The ID of the database to query.",
+			},
+			function.StringParameter{
+				Name:        "sql",
+				Description: "The native query, which can reference template tags using {{tag_name}}.",
+			},
+			function.DynamicParameter{
+				Name:           "template_tags",
+				Description:    "An object containing the template tags used in the query, keyed by their name. Can be null if the query doesn't use any template tags.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func NormalizeJsonFunction() function.Definition {
+	return function.Definition{
+		Summary:     "Normalises a JSON-encoded string",
+		Description: "Normalises a JSON-encoded string by sorting the object keys and removing any insignificant whitespace, in the same way as the resources encode JSON attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "json",
+				Description: "The JSON-encoded string to normalise.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
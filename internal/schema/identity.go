@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+)
+
+// ResourceIdentity returns the identity schema shared by resources which are identified by their numeric ID. The host
+// of the Metabase instance is included so that Terraform can detect when a resource belongs to a different instance.
+func ResourceIdentity(resourceName string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id":   identityIdAttribute(resourceName, true),
+			"host": identityHostAttribute(),
+		},
+	}
+}
+
+// UserIdentity returns the identity schema for users, who can be imported by either their ID or email address.
+func UserIdentity() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id":   identityIdAttribute("user", false),
+			"host": identityHostAttribute(),
+			"email": identityschema.StringAttribute{
+				Description:       "The email address of the user when the identity was first recorded. When importing, either id or email must be provided.",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+// SharedObjectIdentity returns the identity schema for resources which share a card or dashboard, which are identified
+// by the object being shared.
+func SharedObjectIdentity() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"object_type": identityschema.StringAttribute{
+				Description:       "The type of object being shared, either card or dashboard.",
+				RequiredForImport: true,
+			},
+			"id":   identityIdAttribute("card or dashboard being shared", true),
+			"host": identityHostAttribute(),
+		},
+	}
+}
+
+func identityIdAttribute(resourceName string, required bool) identityschema.Int64Attribute {
+	return identityschema.Int64Attribute{
+		Description:       fmt.Sprintf("The ID of the %s.", resourceName),
+		RequiredForImport: required,
+		OptionalForImport: !required,
+	}
+}
+
+func identityHostAttribute() identityschema.StringAttribute {
+	return identityschema.StringAttribute{
+		Description:       "The host URL of the Metabase instance. When importing, this defaults to the host the provider is configured with.",
+		OptionalForImport: true,
+	}
+}
@@ -0,0 +1,115 @@
+package schema
+
+import (
+	dSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	rSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"terraform-provider-metabase/internal/modifiers"
+	"terraform-provider-metabase/internal/validators"
+)
+
+func NativeQuerySnippetResource() rSchema.Schema {
+	return rSchema.Schema{
+		Description:         "Allows for creating and managing native query snippets, which are reusable pieces of SQL that can be included in native queries using {{snippet: name}}. Snippets are archived when destroyed, as Metabase does not support deleting them.",
+		MarkdownDescription: "Allows for creating and managing native query snippets, which are reusable pieces of SQL that can be included in native queries using `{{snippet: name}}`. Snippets are archived when destroyed, as Metabase does not support deleting them.",
+		Attributes: map[string]rSchema.Attribute{
+			"id": rSchema.Int64Attribute{
+				Description: "The ID of the snippet.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": rSchema.StringAttribute{
+				Description: "The name of the snippet, which is used to reference it in queries. This must be unique.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"description": rSchema.StringAttribute{
+				Description: "A description of the snippet.",
+				Optional:    true,
+			},
+			"content": rSchema.StringAttribute{
+				Description: "The SQL the snippet is replaced with. Differences in line endings, trailing whitespace and leading or trailing blank lines between the configured and stored content are ignored.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"collection_id": rSchema.Int64Attribute{
+				Description: "The ID of the snippet folder the snippet is in. This must be a collection in the snippets namespace, and is only supported by Metabase Pro and Enterprise. Defaults to the root folder.",
+				Optional:    true,
+			},
+			"archived": rSchema.BoolAttribute{
+				Description: "Whether the snippet is archived, which hides it from the snippet sidebar. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultToFalseModifier(),
+				},
+			},
+			"creator_id": rSchema.Int64Attribute{
+				Description: "The ID of the user who created the snippet.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": rSchema.StringAttribute{
+				Description: "The timestamp of when the snippet was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func NativeQuerySnippetDataSource() dSchema.Schema {
+	return dSchema.Schema{
+		Description: "Gets the details of a native query snippet, which is looked up by its name. Archived snippets are not included.",
+		Attributes: map[string]dSchema.Attribute{
+			"id": dSchema.Int64Attribute{
+				Description: "The ID of the snippet.",
+				Computed:    true,
+			},
+			"name": dSchema.StringAttribute{
+				Description: "The name of the snippet.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"description": dSchema.StringAttribute{
+				Description: "A description of the snippet.",
+				Computed:    true,
+			},
+			"content": dSchema.StringAttribute{
+				Description: "The SQL the snippet is replaced with.",
+				Computed:    true,
+			},
+			"collection_id": dSchema.Int64Attribute{
+				Description: "The ID of the snippet folder the snippet is in.",
+				Computed:    true,
+			},
+			"archived": dSchema.BoolAttribute{
+				Description: "Whether the snippet is archived.",
+				Computed:    true,
+			},
+			"creator_id": dSchema.Int64Attribute{
+				Description: "The ID of the user who created the snippet.",
+				Computed:    true,
+			},
+			"created_at": dSchema.StringAttribute{
+				Description: "The timestamp of when the snippet was created.",
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,133 @@
+package schema
+
+import (
+	"github.com/bnjns/metabase-sdk-go/service/database"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	rSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/validators"
+)
+
+// ScheduleTypes, ScheduleDays and ScheduleFrames are the values Metabase accepts for the schedules modelled by
+// DatabaseScheduleType, which are shared by dashboard subscriptions and alerts.
+var ScheduleTypes = []string{
+	string(database.ScheduleTypeHourly),
+	string(database.ScheduleTypeDaily),
+	string(database.ScheduleTypeWeekly),
+	string(database.ScheduleTypeMonthly),
+}
+var ScheduleDays = []string{
+	string(database.ScheduleDayTypeSun),
+	string(database.ScheduleDayTypeMon),
+	string(database.ScheduleDayTypeTue),
+	string(database.ScheduleDayTypeWed),
+	string(database.ScheduleDayTypeThu),
+	string(database.ScheduleDayTypeFri),
+	string(database.ScheduleDayTypeSat),
+}
+var ScheduleFrames = []string{
+	string(database.ScheduleFrameTypeFirst),
+	string(database.ScheduleFrameTypeMid),
+	string(database.ScheduleFrameTypeLast),
+}
+
+// NotificationScheduleType is the schedule a notification is sent on. It uses the same attributes as
+// DatabaseScheduleType, except for the minute as notifications are always sent on the hour.
+var NotificationScheduleType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":  types.StringType,
+		"hour":  types.Int64Type,
+		"day":   types.StringType,
+		"frame": types.StringType,
+	},
+}
+
+func notificationScheduleAttribute() rSchema.SingleNestedAttribute {
+	return rSchema.SingleNestedAttribute{
+		Description: "The schedule the notification is sent on.",
+		Required:    true,
+		Validators: []validator.Object{
+			validators.ScheduleValidator(),
+		},
+		Attributes: map[string]rSchema.Attribute{
+			"type": rSchema.StringAttribute{
+				Description: "How often the notification is sent. Must be one of hourly, daily, weekly or monthly.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.OneOfStringValidator(ScheduleTypes...),
+				},
+			},
+			"hour": rSchema.Int64Attribute{
+				Description:         "The hour of the day (0-23) the notification is sent at, in the timezone of the Metabase instance. Required unless the type is hourly.",
+				MarkdownDescription: "The hour of the day (0-23) the notification is sent at, in the timezone of the Metabase instance. Required unless the `type` is `hourly`.",
+				Optional:            true,
+			},
+			"day": rSchema.StringAttribute{
+				Description:         "The day of the week (sun-sat) the notification is sent on. Required when the type is weekly, and can be used with a frame of first or last when the type is monthly.",
+				MarkdownDescription: "The day of the week (`sun`-`sat`) the notification is sent on. Required when the `type` is `weekly`, and can be used with a `frame` of `first` or `last` when the `type` is `monthly`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.OneOfStringValidator(ScheduleDays...),
+				},
+			},
+			"frame": rSchema.StringAttribute{
+				Description:         "The part of the month (first, mid or last) the notification is sent in. Required when the type is monthly.",
+				MarkdownDescription: "The part of the month (`first`, `mid` or `last`) the notification is sent in. Required when the `type` is `monthly`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.OneOfStringValidator(ScheduleFrames...),
+				},
+			},
+		},
+	}
+}
+
+var NotificationEmailChannelType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"user_ids":  types.SetType{ElemType: types.Int64Type},
+		"addresses": types.SetType{ElemType: types.StringType},
+	},
+}
+var NotificationSlackChannelType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"channel": types.StringType,
+	},
+}
+
+func notificationEmailChannelAttribute() rSchema.SingleNestedAttribute {
+	return rSchema.SingleNestedAttribute{
+		Description:         "Sends the notification by email. At least one of email or slack must be configured.",
+		MarkdownDescription: "Sends the notification by email. At least one of `email` or `slack` must be configured.",
+		Optional:            true,
+		Attributes: map[string]rSchema.Attribute{
+			"user_ids": rSchema.SetAttribute{
+				ElementType: types.Int64Type,
+				Description: "The IDs of the Metabase users to send the notification to.",
+				Optional:    true,
+			},
+			"addresses": rSchema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The email addresses of any recipients who aren't Metabase users.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func notificationSlackChannelAttribute() rSchema.SingleNestedAttribute {
+	return rSchema.SingleNestedAttribute{
+		Description:         "Sends the notification to Slack, which must be connected to Metabase. At least one of email or slack must be configured.",
+		MarkdownDescription: "Sends the notification to Slack, which must be connected to Metabase. At least one of `email` or `slack` must be configured.",
+		Optional:            true,
+		Attributes: map[string]rSchema.Attribute{
+			"channel": rSchema.StringAttribute{
+				Description: "The Slack channel (eg, #general) or user (eg, @someone) to send the notification to.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+		},
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	dSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	lSchema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	rSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"terraform-provider-metabase/internal/validators"
@@ -28,16 +29,74 @@ func PermissionsGroupResource() rSchema.Schema {
 
 func PermissionsGroupDataSource() dSchema.Schema {
 	return dSchema.Schema{
-		Description: "Gets the details of the provided permissions (user) group.",
+		Description: "Gets the details of the provided permissions (user) group, which can be looked up by either its ID or name.",
 		Attributes: map[string]dSchema.Attribute{
 			"id": dSchema.Int64Attribute{
-				Description: "The ID of the permissions group.",
-				Required:    true,
+				Description:         "The ID of the permissions group. Exactly one of id or name must be provided.",
+				MarkdownDescription: "The ID of the permissions group. Exactly one of `id` or `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"name": dSchema.StringAttribute{
-				Description: "The name of the permissions group.",
+				Description:         "The name of the permissions group. Exactly one of id or name must be provided.",
+				MarkdownDescription: "The name of the permissions group. Exactly one of `id` or `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+		},
+	}
+}
+
+func PermissionsGroupsDataSource() dSchema.Schema {
+	return dSchema.Schema{
+		Description: "Gets the details of all permissions (user) groups, including the built-in groups.",
+		Attributes: map[string]dSchema.Attribute{
+			"all_users_group_id": dSchema.Int64Attribute{
+				Description: "The ID of the built-in 'All Users' group, which every user is a member of.",
 				Computed:    true,
 			},
+			"administrators_group_id": dSchema.Int64Attribute{
+				Description: "The ID of the built-in 'Administrators' group.",
+				Computed:    true,
+			},
+			"groups": dSchema.ListNestedAttribute{
+				Description: "The permissions groups, ordered by ID.",
+				Computed:    true,
+				NestedObject: dSchema.NestedAttributeObject{
+					Attributes: map[string]dSchema.Attribute{
+						"id": dSchema.Int64Attribute{
+							Description: "The ID of the permissions group.",
+							Computed:    true,
+						},
+						"name": dSchema.StringAttribute{
+							Description: "The name of the permissions group.",
+							Computed:    true,
+						},
+						"member_count": dSchema.Int64Attribute{
+							Description: "The number of users which are members of the group.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func PermissionsGroupListResource() lSchema.Schema {
+	return lSchema.Schema{
+		Description: "Lists the permissions groups in Metabase, so that they can be imported using terraform query. The built-in All Users and Administrators groups cannot be managed, so are not included.",
+		Attributes: map[string]lSchema.Attribute{
+			"name_prefix": lSchema.StringAttribute{
+				Description: "Only include groups whose name starts with this prefix.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
 		},
 	}
 }
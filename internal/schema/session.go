@@ -0,0 +1,41 @@
+package schema
+
+import (
+	eSchema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"terraform-provider-metabase/internal/validators"
+)
+
+func SessionEphemeralResource() eSchema.Schema {
+	return eSchema.Schema{
+		Description:         "Creates a Metabase session which only lasts for the duration of the Terraform run. The session is never stored in the plan or state, and is logged out once Terraform has finished with it.",
+		MarkdownDescription: "Creates a Metabase session which only lasts for the duration of the Terraform run. The session is never stored in the plan or state, and is logged out once Terraform has finished with it.\n\nThe provider's `username` and `password` are used unless credentials are provided explicitly.",
+		Attributes: map[string]eSchema.Attribute{
+			"username": eSchema.StringAttribute{
+				Description: "The username (email) to log in with. Defaults to the username the provider is configured with.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"password": eSchema.StringAttribute{
+				Description: "The password to log in with. Defaults to the password the provider is configured with.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"host": eSchema.StringAttribute{
+				Description: "The Host URL of the Metabase instance the session was created for.",
+				Computed:    true,
+			},
+			"token": eSchema.StringAttribute{
+				Description:         "The session token. This should be sent in the X-Metabase-Session header, or used as the session_token of another provider.",
+				MarkdownDescription: "The session token. This should be sent in the `X-Metabase-Session` header, or used as the `session_token` of another provider.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
@@ -0,0 +1,134 @@
+package schema
+
+import (
+	"fmt"
+	rSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-metabase/internal/validators"
+)
+
+var EmbeddingParamValues = []string{"enabled", "locked", "disabled"}
+
+func PublicLinkResource() rSchema.Schema {
+	attributes := sharedObjectAttributes("the public link is for")
+	attributes["uuid"] = rSchema.StringAttribute{
+		Description: "The UUID of the public link.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["public_url"] = rSchema.StringAttribute{
+		Description: "The URL anyone can use to view the card or dashboard, without logging in to Metabase.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	return rSchema.Schema{
+		Description: "Allows for creating a public link to a card or dashboard, which lets anyone view it without logging in to Metabase. Public sharing must be enabled in the admin settings. Destroying the resource revokes the link.",
+		Attributes:  attributes,
+	}
+}
+
+func EmbeddingResource() rSchema.Schema {
+	attributes := sharedObjectAttributes("to embed")
+	attributes["enable_embedding"] = rSchema.BoolAttribute{
+		Description: "Whether the card or dashboard can be embedded in other applications using a signed JWT. Defaults to true.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	}
+	attributes["embedding_params"] = rSchema.MapAttribute{
+		Description:         "How each of the parameters, keyed by their slug, can be used in the embedded card or dashboard. Use enabled to allow the viewer to change the parameter, locked to require it is set in the signed JWT or disabled to hide it. Parameters which aren't included are disabled.",
+		MarkdownDescription: "How each of the parameters, keyed by their slug, can be used in the embedded card or dashboard. Use `enabled` to allow the viewer to change the parameter, `locked` to require it is set in the signed JWT or `disabled` to hide it. Parameters which aren't included are disabled.",
+		ElementType:         types.StringType,
+		Optional:            true,
+	}
+
+	return rSchema.Schema{
+		Description:         "Allows for managing the static embedding of a card or dashboard, so that it can be embedded in other applications using a signed JWT. Embedding must be enabled, which can be done using the metabase_embedding_settings resource. Destroying the resource disables embedding of the card or dashboard.",
+		MarkdownDescription: "Allows for managing the static embedding of a card or dashboard, so that it can be embedded in other applications using a signed JWT. Embedding must be enabled, which can be done using the `metabase_embedding_settings` resource. Destroying the resource disables embedding of the card or dashboard.",
+		Attributes:          attributes,
+	}
+}
+
+func EmbeddingSettingsResource() rSchema.Schema {
+	return rSchema.Schema{
+		Description: "Allows for enabling static embedding and configuring the secret key used to sign embedding JWTs. There should only be one of these resources per Metabase instance. Destroying the resource disables embedding and removes the secret key.",
+		Attributes: map[string]rSchema.Attribute{
+			"id": rSchema.StringAttribute{
+				Description:         "The ID of the settings, which is always embedding.",
+				MarkdownDescription: "The ID of the settings, which is always `embedding`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": rSchema.BoolAttribute{
+				Description: "Whether cards and dashboards can be embedded in other applications. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"embedding_secret_key": rSchema.StringAttribute{
+				Description:         "The secret key used to sign embedding JWTs, which must be a 64 character hexadecimal string. This is write-only, so is never stored in the plan or state, and is only set when the resource is created, embedding_secret_key_version changes or the key is changed outside of Terraform.",
+				MarkdownDescription: "The secret key used to sign embedding JWTs, which must be a 64 character hexadecimal string. This is write-only, so is never stored in the plan or state, and is only set when the resource is created, `embedding_secret_key_version` changes or the key is changed outside of Terraform.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"embedding_secret_key_version": rSchema.StringAttribute{
+				Description:         "An arbitrary value which, when changed, causes the embedding_secret_key to be set again.",
+				MarkdownDescription: "An arbitrary value which, when changed, causes the `embedding_secret_key` to be set again.",
+				Optional:            true,
+			},
+			"embedding_secret_key_fingerprint": rSchema.StringAttribute{
+				Description: "Identifies the secret key stored in Metabase without revealing it, so that changes made outside of Terraform can be detected. This is the redacted key returned by Metabase, or a hash of the key if it is not redacted.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// sharedObjectAttributes returns the attributes which identify the card or dashboard being shared.
+func sharedObjectAttributes(purpose string) map[string]rSchema.Attribute {
+	return map[string]rSchema.Attribute{
+		"id": rSchema.StringAttribute{
+			Description:         "The ID of the resource, in the format card/<card_id> or dashboard/<dashboard_id>.",
+			MarkdownDescription: "The ID of the resource, in the format `card/<card_id>` or `dashboard/<dashboard_id>`.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"card_id": rSchema.Int64Attribute{
+			Description:         fmt.Sprintf("The ID of the card (question or model) %s. Exactly one of card_id or dashboard_id must be provided.", purpose),
+			MarkdownDescription: fmt.Sprintf("The ID of the card (question or model) %s. Exactly one of `card_id` or `dashboard_id` must be provided.", purpose),
+			Optional:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"dashboard_id": rSchema.Int64Attribute{
+			Description:         fmt.Sprintf("The ID of the dashboard %s. Exactly one of card_id or dashboard_id must be provided.", purpose),
+			MarkdownDescription: fmt.Sprintf("The ID of the dashboard %s. Exactly one of `card_id` or `dashboard_id` must be provided.", purpose),
+			Optional:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
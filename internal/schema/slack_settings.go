@@ -0,0 +1,58 @@
+package schema
+
+import (
+	rSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"terraform-provider-metabase/internal/validators"
+)
+
+func SlackSettingsResource() rSchema.Schema {
+	return rSchema.Schema{
+		Description: "Allows for connecting Metabase to Slack, so that dashboard subscriptions and alerts can be sent to Slack. There should only be one of these resources per Metabase instance. Destroying the resource disconnects Slack.",
+		Attributes: map[string]rSchema.Attribute{
+			"id": rSchema.StringAttribute{
+				Description:         "The ID of the settings, which is always slack.",
+				MarkdownDescription: "The ID of the settings, which is always `slack`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_token": rSchema.StringAttribute{
+				Description:         "The Bot User OAuth Token of the Slack app. This is write-only, so is never stored in the plan or state, and is only set when the resource is created, app_token_version changes or the token is changed outside of Terraform.",
+				MarkdownDescription: "The Bot User OAuth Token of the Slack app. This is write-only, so is never stored in the plan or state, and is only set when the resource is created, `app_token_version` changes or the token is changed outside of Terraform.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"app_token_version": rSchema.StringAttribute{
+				Description:         "An arbitrary value which, when changed, causes the app_token to be set again.",
+				MarkdownDescription: "An arbitrary value which, when changed, causes the `app_token` to be set again.",
+				Optional:            true,
+			},
+			"app_token_fingerprint": rSchema.StringAttribute{
+				Description: "Identifies the app token stored in Metabase without revealing it, so that changes made outside of Terraform can be detected. This is the redacted token returned by Metabase, or a hash of the token if it is not redacted.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bug_report_channel": rSchema.StringAttribute{
+				Description: "The Slack channel bug reports are sent to, without the leading #.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"token_valid": rSchema.BoolAttribute{
+				Description: "Whether Metabase considers the app token to be valid.",
+				Computed:    true,
+			},
+		},
+	}
+}
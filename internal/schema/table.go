@@ -0,0 +1,128 @@
+package schema
+
+import (
+	lSchema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	rSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"terraform-provider-metabase/internal/validators"
+)
+
+var TableVisibilityTypes = []string{"hidden", "technical", "cruft"}
+var TableFieldOrders = []string{"database", "alphabetical", "custom", "smart"}
+
+func TableResource() rSchema.Schema {
+	return rSchema.Schema{
+		Description: "Allows for managing the metadata of a table which has been synced from a database.",
+		Attributes: map[string]rSchema.Attribute{
+			"id": rSchema.Int64Attribute{
+				Description: "The ID of the table.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_id": rSchema.Int64Attribute{
+				Description: "The ID of the database the table belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"schema": rSchema.StringAttribute{
+				Description: "The schema the table belongs to. Leave unset for databases which don't support schemas.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": rSchema.StringAttribute{
+				Description: "The name of the table in the database.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": rSchema.StringAttribute{
+				Description: "The name of the table displayed in Metabase.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": rSchema.StringAttribute{
+				Description: "The description of the table.",
+				Optional:    true,
+			},
+			"caveats": rSchema.StringAttribute{
+				Description: "Any caveats users should be aware of when using the table.",
+				Optional:    true,
+			},
+			"points_of_interest": rSchema.StringAttribute{
+				Description: "Anything interesting users should know about the table.",
+				Optional:    true,
+			},
+			"visibility_type": rSchema.StringAttribute{
+				Description:         "Whether the table is hidden from users, and why. Must be one of hidden, technical or cruft. Leave unset for the table to be visible.",
+				MarkdownDescription: "Whether the table is hidden from users, and why. Must be one of `hidden`, `technical` or `cruft`. Leave unset for the table to be visible.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.OneOfStringValidator(TableVisibilityTypes...),
+				},
+			},
+			"entity_type": rSchema.StringAttribute{
+				Description:         "The type of entity the table represents (eg, entity/UserTable, entity/TransactionTable).",
+				MarkdownDescription: "The type of entity the table represents (eg, `entity/UserTable`, `entity/TransactionTable`).",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"field_order": rSchema.StringAttribute{
+				Description:         "How the table's fields are ordered. Must be one of database, alphabetical, custom or smart.",
+				MarkdownDescription: "How the table's fields are ordered. Must be one of `database`, `alphabetical`, `custom` or `smart`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					validators.OneOfStringValidator(TableFieldOrders...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": rSchema.BoolAttribute{
+				Description: "Whether the table still exists in the database, as of the last sync.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func TableListResource() lSchema.Schema {
+	return lSchema.Schema{
+		Description: "Lists the active tables which have been synced into Metabase, so that their metadata can be imported using terraform query.",
+		Attributes: map[string]lSchema.Attribute{
+			"database_id": lSchema.Int64Attribute{
+				Description: "Only include tables in this database.",
+				Optional:    true,
+			},
+			"schema": lSchema.StringAttribute{
+				Description: "Only include tables in this schema.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+		},
+	}
+}
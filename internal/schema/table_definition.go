@@ -0,0 +1,86 @@
+package schema
+
+import (
+	rSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"terraform-provider-metabase/internal/validators"
+)
+
+func SegmentResource() rSchema.Schema {
+	return tableDefinitionResource(
+		"segment",
+		"Allows for creating and managing segments, which are named filters on a table that can be reused in questions. Segments are archived when destroyed.",
+		"Serialised JSON string containing the MBQL query which defines the segment's filter, eg {\"source-table\": 1, \"filter\": [\"=\", [\"field\", 2, null], \"active\"]}. This is compared semantically, so formatting changes do not update the segment.",
+	)
+}
+
+func LegacyMetricResource() rSchema.Schema {
+	return tableDefinitionResource(
+		"legacy metric",
+		"Allows for creating and managing legacy metrics, which are named aggregations on a table that can be reused in questions. Legacy metrics are archived when destroyed.",
+		"Serialised JSON string containing the MBQL query which defines the metric's aggregation, eg {\"source-table\": 1, \"aggregation\": [[\"sum\", [\"field\", 2, null]]]}. This is compared semantically, so formatting changes do not update the metric.",
+	)
+}
+
+// tableDefinitionResource returns the schema shared by segments and legacy metrics, which have the same attributes.
+func tableDefinitionResource(noun string, description string, definitionDescription string) rSchema.Schema {
+	return rSchema.Schema{
+		Description: description,
+		Attributes: map[string]rSchema.Attribute{
+			"id": rSchema.Int64Attribute{
+				Description: "The ID of the " + noun + ".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": rSchema.StringAttribute{
+				Description: "The name of the " + noun + ".",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"description": rSchema.StringAttribute{
+				Description: "A description of the " + noun + ".",
+				Optional:    true,
+			},
+			"table_id": rSchema.Int64Attribute{
+				Description: "The ID of the table the " + noun + " is defined on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"definition": rSchema.StringAttribute{
+				Description: definitionDescription,
+				Required:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"revision_message": rSchema.StringAttribute{
+				Description:         "A message describing the latest change, which is recorded in the revision history. Metabase requires a message when updating the " + noun + ", so this must be set when changing the name, description or definition.",
+				MarkdownDescription: "A message describing the latest change, which is recorded in the revision history. Metabase requires a message when updating the " + noun + ", so this must be set when changing the `name`, `description` or `definition`.",
+				Optional:            true,
+			},
+			"creator_id": rSchema.Int64Attribute{
+				Description: "The ID of the user who created the " + noun + ".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": rSchema.StringAttribute{
+				Description: "The timestamp of when the " + noun + " was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
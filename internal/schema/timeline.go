@@ -0,0 +1,153 @@
+package schema
+
+import (
+	rSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"terraform-provider-metabase/internal/validators"
+)
+
+var TimelineIcons = []string{"star", "balloons", "mail", "warning", "bell", "cloud"}
+
+func TimelineResource() rSchema.Schema {
+	return rSchema.Schema{
+		Description: "Allows for creating and managing timelines, which group events shown on the time series charts in a collection. Timelines and their events are archived when destroyed.",
+		Attributes: map[string]rSchema.Attribute{
+			"id": rSchema.Int64Attribute{
+				Description: "The ID of the timeline.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": rSchema.StringAttribute{
+				Description: "The name of the timeline.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"description": rSchema.StringAttribute{
+				Description: "A description of the timeline.",
+				Optional:    true,
+			},
+			"icon": rSchema.StringAttribute{
+				Description:         "The default icon for the timeline's events. Must be one of star, balloons, mail, warning, bell or cloud. Defaults to star.",
+				MarkdownDescription: "The default icon for the timeline's events. Must be one of `star`, `balloons`, `mail`, `warning`, `bell` or `cloud`. Defaults to `star`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("star"),
+				Validators: []validator.String{
+					validators.OneOfStringValidator(TimelineIcons...),
+				},
+			},
+			"collection_id": rSchema.Int64Attribute{
+				Description: "The ID of the collection the timeline is in. The timeline's events are shown on the charts in this collection. Defaults to the root collection.",
+				Optional:    true,
+			},
+			"default": rSchema.BoolAttribute{
+				Description: "Whether this is the collection's default timeline, which events created from charts are added to. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"creator_id": rSchema.Int64Attribute{
+				Description: "The ID of the user who created the timeline.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": rSchema.StringAttribute{
+				Description: "The timestamp of when the timeline was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func TimelineEventResource() rSchema.Schema {
+	return rSchema.Schema{
+		Description: "Allows for creating and managing events on a timeline, such as releases or incidents. Events are archived when destroyed, which hides them from charts.",
+		Attributes: map[string]rSchema.Attribute{
+			"id": rSchema.Int64Attribute{
+				Description: "The ID of the event.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeline_id": rSchema.Int64Attribute{
+				Description: "The ID of the timeline the event is on.",
+				Required:    true,
+			},
+			"name": rSchema.StringAttribute{
+				Description: "The name of the event.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"description": rSchema.StringAttribute{
+				Description: "A description of the event, which supports Markdown.",
+				Optional:    true,
+			},
+			"timestamp": rSchema.StringAttribute{
+				Description: "The RFC 3339 timestamp of when the event happened, eg 2024-01-01T09:00:00Z. Equivalent timestamps in different formats are not considered to be a change.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.TimestampValidator(),
+				},
+			},
+			"time_matters": rSchema.BoolAttribute{
+				Description:         "Whether the time of the event is shown, rather than just the date. Defaults to false.",
+				MarkdownDescription: "Whether the time of the event is shown, rather than just the date. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"timezone": rSchema.StringAttribute{
+				Description: "The IANA timezone the event is displayed in, eg Europe/London. Defaults to UTC.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("UTC"),
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"icon": rSchema.StringAttribute{
+				Description:         "The icon shown for the event. Must be one of star, balloons, mail, warning, bell or cloud. Defaults to the timeline's icon.",
+				MarkdownDescription: "The icon shown for the event. Must be one of `star`, `balloons`, `mail`, `warning`, `bell` or `cloud`. Defaults to the timeline's icon.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					validators.OneOfStringValidator(TimelineIcons...),
+				},
+			},
+			"creator_id": rSchema.Int64Attribute{
+				Description: "The ID of the user who created the event.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": rSchema.StringAttribute{
+				Description: "The timestamp of when the event was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
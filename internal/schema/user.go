@@ -1,8 +1,12 @@
 package schema
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	dSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	lSchema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	rSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -12,14 +16,35 @@ import (
 
 type dataSourceType int
 
+var UserGroupMembershipType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"group_id":         types.Int64Type,
+		"is_group_manager": types.BoolType,
+	},
+}
+
 const (
 	DataSourceTypeUser dataSourceType = iota
 	DataSourceTypeCurrentUser
+	DataSourceTypeUsers
 )
 
+// UserOnDestroyActions are the actions which can be taken when a user resource is destroyed.
+var UserOnDestroyActions = []string{
+	"deactivate",
+	"keep",
+}
+
+// UserStatuses are the statuses which can be used to filter the users data source.
+var UserStatuses = []string{
+	"active",
+	"deactivated",
+	"all",
+}
+
 func UserResource() rSchema.Schema {
 	return rSchema.Schema{
-		Description: "Allows for creating and managing users in Metabase.",
+		Description: "Allows for creating and managing users in Metabase. If email is configured in Metabase, it sends the user an invitation email when they are created.",
 		Attributes: map[string]rSchema.Attribute{
 			"id": rSchema.Int64Attribute{
 				Description: "The ID of the user.",
@@ -54,11 +79,22 @@ func UserResource() rSchema.Schema {
 				Description: "The locale the user has configured for themselves. The site default is used if this is nil.",
 				Optional:    true,
 			},
-			"group_ids": rSchema.ListAttribute{
-				ElementType: types.Int64Type,
-				Description: "The IDs of the user groups the user is a member of. The 'All Users' group is automatically added by Metabase and you can use `is_superuser` to add the user to the 'Administrators' group.",
+			"login_attributes": rSchema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Attributes of the user which can be used by data sandboxing and connection impersonation. Any existing attributes (e.g. those set by SSO) are left as they are if this is not set.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_ids": rSchema.ListAttribute{
+				ElementType:         types.Int64Type,
+				Description:         "The IDs of the user groups the user is a member of. The 'All Users' group is automatically added by Metabase and you can use is_superuser to add the user to the 'Administrators' group. Conflicts with group_memberships.",
+				MarkdownDescription: "The IDs of the user groups the user is a member of. The 'All Users' group is automatically added by Metabase and you can use `is_superuser` to add the user to the 'Administrators' group. Conflicts with `group_memberships`.",
+				DeprecationMessage:  "Use group_memberships instead, which also supports making the user a manager of the group.",
+				Optional:            true,
+				Computed:            true,
 				Validators: []validator.List{
 					validators.UserNotInReservedGroupsValidator(),
 				},
@@ -66,6 +102,26 @@ func UserResource() rSchema.Schema {
 					modifiers.DefaultToEmptyListModifier(types.Int64Type),
 				},
 			},
+			"group_memberships": rSchema.SetNestedAttribute{
+				Description:         "The user groups the user is a member of. The 'All Users' group is automatically added by Metabase and you can use is_superuser to add the user to the 'Administrators' group. Conflicts with group_ids.",
+				MarkdownDescription: "The user groups the user is a member of. The 'All Users' group is automatically added by Metabase and you can use `is_superuser` to add the user to the 'Administrators' group. Conflicts with `group_ids`.",
+				Optional:            true,
+				Computed:            true,
+				NestedObject: rSchema.NestedAttributeObject{
+					Attributes: map[string]rSchema.Attribute{
+						"group_id": rSchema.Int64Attribute{
+							Description: "The ID of the group.",
+							Required:    true,
+						},
+						"is_group_manager": rSchema.BoolAttribute{
+							Description: "Whether the user is a manager of the group. Group managers are only supported by Metabase Pro and Enterprise. Defaults to false.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
 			"google_auth": rSchema.BoolAttribute{
 				Description: "Whether the user was created via Google SSO. Note, if this is enabled then username/password log-in will not be possible.",
 				Computed:    true,
@@ -115,99 +171,272 @@ func UserResource() rSchema.Schema {
 				Description: "The timestamp of when the user was last updated.",
 				Computed:    true,
 			},
+			"sso_source": rSchema.StringAttribute{
+				Description: "The SSO provider the user logs in with (e.g. google, ldap, saml or jwt). This is null for users who log in with a password.",
+				Computed:    true,
+			},
+			"personal_collection_id": rSchema.Int64Attribute{
+				Description: "The ID of the user's personal collection.",
+				Computed:    true,
+			},
+			"password": rSchema.StringAttribute{
+				Description:         "The password for the user to log in with. This is write-only, so is never stored in the plan or state, and is only set when the user is created or password_version changes.",
+				MarkdownDescription: "The password for the user to log in with. This is write-only, so is never stored in the plan or state, and is only set when the user is created or `password_version` changes.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
+			},
+			"password_version": rSchema.StringAttribute{
+				Description:         "An arbitrary value which, when changed, causes the password to be set again.",
+				MarkdownDescription: "An arbitrary value which, when changed, causes the `password` to be set again.",
+				Optional:            true,
+			},
+			"send_invite": rSchema.BoolAttribute{
+				Description: "Whether to send the user their invitation email again, using the send invite API. Metabase always sends the invitation when the user is created if email is configured, and this cannot be turned off, so setting this to true when creating the user sends a second invitation. Changing this to true on an existing user sends the invitation again, eg once email has been configured. This requires email to be configured in Metabase. Defaults to false.",
+				Optional:    true,
+			},
+			"on_destroy": rSchema.StringAttribute{
+				Description:         "What to do with the user when the resource is destroyed, as users cannot be deleted in Metabase. Must be one of deactivate or keep. Defaults to deactivate.",
+				MarkdownDescription: "What to do with the user when the resource is destroyed, as users cannot be deleted in Metabase. Must be one of `deactivate` or `keep`. Defaults to `deactivate`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.OneOfStringValidator(UserOnDestroyActions...),
+				},
+			},
+			"reactivate_on_drift": rSchema.BoolAttribute{
+				Description: "Whether to reactivate the user if they have been deactivated outside of Terraform. Users which are deactivated when imported are imported as they are, and reactivated on the next apply. If false, the user is left deactivated and a warning is shown instead. Defaults to true.",
+				Optional:    true,
+			},
 		},
 	}
 }
 
 func (t dataSourceType) makeDescription() string {
 	if t == DataSourceTypeUser {
-		return "Gets the details of the provided user."
+		return "Gets the details of the provided user, which can be looked up by either their ID or email address."
 	} else if t == DataSourceTypeCurrentUser {
 		return "Gets the details of the currently logged-in user."
+	} else if t == DataSourceTypeUsers {
+		return "Gets the details of all users matching the provided filters."
 	} else {
 		return ""
 	}
 }
 
 func UserDataSource(dataSourceType dataSourceType) dSchema.Schema {
+	attributes := map[string]dSchema.Attribute{
+		"id": dSchema.Int64Attribute{
+			Description: "The ID of the user.",
+			Computed:    true,
+		},
+		"email": dSchema.StringAttribute{
+			Description: "The email address of the user.",
+			Computed:    true,
+		},
+		"first_name": dSchema.StringAttribute{
+			Description: "The first name of the user.",
+			Computed:    true,
+		},
+		"last_name": dSchema.StringAttribute{
+			Description: "The last name of the user.",
+			Computed:    true,
+		},
+		"common_name": dSchema.StringAttribute{
+			Description: "The user's common name, which is a combination of their first and last names.",
+			Computed:    true,
+		},
+		"locale": dSchema.StringAttribute{
+			Description: "The locale the user has configured for themselves. The site default is used if this is nil.",
+			Computed:    true,
+		},
+		"login_attributes": dSchema.MapAttribute{
+			ElementType: types.StringType,
+			Description: "Attributes of the user which can be used by data sandboxing and connection impersonation.",
+			Computed:    true,
+		},
+		"group_ids": dSchema.ListAttribute{
+			ElementType: types.Int64Type,
+			Description: "The IDs of the user groups the user is a member of.",
+			Computed:    true,
+		},
+		"group_memberships": dSchema.SetNestedAttribute{
+			Description: "The user groups the user is a member of.",
+			Computed:    true,
+			NestedObject: dSchema.NestedAttributeObject{
+				Attributes: map[string]dSchema.Attribute{
+					"group_id": dSchema.Int64Attribute{
+						Description: "The ID of the group.",
+						Computed:    true,
+					},
+					"is_group_manager": dSchema.BoolAttribute{
+						Description: "Whether the user is a manager of the group.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"google_auth": dSchema.BoolAttribute{
+			Description: "Whether the user was created via Google SSO. Note, if this is enabled then username/password log-in will not be possible.",
+			Computed:    true,
+		},
+		"ldap_auth": dSchema.BoolAttribute{
+			Description: "Whether the user was created via LDAP. Note, if this is enabled then username/password log-in will not be possible.",
+			Computed:    true,
+		},
+		"is_active": dSchema.BoolAttribute{
+			Description: "Used to indicate whether a user is active or if they've been deleted.",
+			Computed:    true,
+		},
+		"is_installer": dSchema.BoolAttribute{
+			Computed: true,
+		},
+		"is_qbnewb": dSchema.BoolAttribute{
+			Description: "If false then the user has been introduced to how the Query Builder works.",
+			Computed:    true,
+		},
+		"is_superuser": dSchema.BoolAttribute{
+			Description: "Whether the user is a member of the built-in Admin group.",
+			Computed:    true,
+		},
+		"has_invited_second_user": dSchema.BoolAttribute{
+			Computed: true,
+		},
+		"has_question_and_dashboard": dSchema.BoolAttribute{
+			Computed: true,
+		},
+		"date_joined": dSchema.StringAttribute{
+			Description: "The timestamp of when the user was created.",
+			Computed:    true,
+		},
+		"first_login": dSchema.StringAttribute{
+			Description: "The timestamp of when the user first logged into Metabase.",
+			Computed:    true,
+		},
+		"last_login": dSchema.StringAttribute{
+			Description: "The timestamp of the user's most recent login to Metabase.",
+			Computed:    true,
+		},
+		"updated_at": dSchema.StringAttribute{
+			Description: "The timestamp of when the user was last updated.",
+			Computed:    true,
+		},
+		"sso_source": dSchema.StringAttribute{
+			Description: "The SSO provider the user logs in with (e.g. google, ldap, saml or jwt). This is null for users who log in with a password.",
+			Computed:    true,
+		},
+		"personal_collection_id": dSchema.Int64Attribute{
+			Description: "The ID of the user's personal collection.",
+			Computed:    true,
+		},
+	}
+
+	if dataSourceType == DataSourceTypeUser {
+		attributes["id"] = dSchema.Int64Attribute{
+			Description:         "The ID of the user. Exactly one of id or email must be provided.",
+			MarkdownDescription: "The ID of the user. Exactly one of `id` or `email` must be provided.",
+			Optional:            true,
+			Computed:            true,
+		}
+		attributes["email"] = dSchema.StringAttribute{
+			Description:         "The email address of the user. This is case-insensitive. Exactly one of id or email must be provided.",
+			MarkdownDescription: "The email address of the user. This is case-insensitive. Exactly one of `id` or `email` must be provided.",
+			Optional:            true,
+			Computed:            true,
+		}
+		attributes["include_deactivated"] = dSchema.BoolAttribute{
+			Description: "Whether to also search for users which have been deactivated. Defaults to false.",
+			Optional:    true,
+		}
+	}
 
 	return dSchema.Schema{
 		Description: dataSourceType.makeDescription(),
+		Attributes:  attributes,
+	}
+}
+
+func UsersDataSource() dSchema.Schema {
+	return dSchema.Schema{
+		Description: DataSourceTypeUsers.makeDescription(),
 		Attributes: map[string]dSchema.Attribute{
-			"id": dSchema.Int64Attribute{
-				Description: "The ID of the user.",
-				Required:    dataSourceType == DataSourceTypeUser,
-				Computed:    dataSourceType == DataSourceTypeCurrentUser,
-			},
 			"email": dSchema.StringAttribute{
-				Description: "The email address of the user.",
-				Computed:    true,
-			},
-			"first_name": dSchema.StringAttribute{
-				Description: "The first name of the user.",
-				Computed:    true,
-			},
-			"last_name": dSchema.StringAttribute{
-				Description: "The last name of the user.",
-				Computed:    true,
-			},
-			"common_name": dSchema.StringAttribute{
-				Description: "The user's common name, which is a combination of their first and last names.",
-				Computed:    true,
-			},
-			"locale": dSchema.StringAttribute{
-				Description: "The locale the user has configured for themselves. The site default is used if this is nil.",
-				Computed:    true,
-			},
-			"group_ids": dSchema.ListAttribute{
-				ElementType: types.Int64Type,
-				Description: "The IDs of the user groups the user is a member of.",
-				Computed:    true,
-			},
-			"google_auth": dSchema.BoolAttribute{
-				Description: "Whether the user was created via Google SSO. Note, if this is enabled then username/password log-in will not be possible.",
-				Computed:    true,
+				Description: "Only include the user with this email address. This is case-insensitive.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
 			},
-			"ldap_auth": dSchema.BoolAttribute{
-				Description: "Whether the user was created via LDAP. Note, if this is enabled then username/password log-in will not be possible.",
-				Computed:    true,
+			"email_domain": dSchema.StringAttribute{
+				Description: "Only include users whose email address belongs to this domain (e.g. example.com). This is case-insensitive.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
 			},
-			"is_active": dSchema.BoolAttribute{
-				Description: "Used to indicate whether a user is active or if they've been deleted.",
-				Computed:    true,
+			"group_id": dSchema.Int64Attribute{
+				Description: "Only include users who are members of this group.",
+				Optional:    true,
 			},
-			"is_installer": dSchema.BoolAttribute{
-				Computed: true,
+			"status": dSchema.StringAttribute{
+				Description:         "Only include users with this status. Must be one of active, deactivated or all. Defaults to active.",
+				MarkdownDescription: "Only include users with this status. Must be one of `active`, `deactivated` or `all`. Defaults to `active`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.OneOfStringValidator(UserStatuses...),
+				},
 			},
-			"is_qbnewb": dSchema.BoolAttribute{
-				Description: "If false then the user has been introduced to how the Query Builder works.",
-				Computed:    true,
+			"sso_source": dSchema.StringAttribute{
+				Description:         "Only include users who log in with this SSO source (e.g. google, ldap, saml or jwt).",
+				MarkdownDescription: "Only include users who log in with this SSO source (e.g. `google`, `ldap`, `saml` or `jwt`).",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
 			},
-			"is_superuser": dSchema.BoolAttribute{
-				Description: "Whether the user is a member of the built-in Admin group.",
+			"users": dSchema.ListNestedAttribute{
+				Description: "The users matching the filters, ordered by ID.",
 				Computed:    true,
+				NestedObject: dSchema.NestedAttributeObject{
+					Attributes: UserDataSource(DataSourceTypeUsers).Attributes,
+				},
 			},
-			"has_invited_second_user": dSchema.BoolAttribute{
-				Computed: true,
-			},
-			"has_question_and_dashboard": dSchema.BoolAttribute{
-				Computed: true,
-			},
-			"date_joined": dSchema.StringAttribute{
-				Description: "The timestamp of when the user was created.",
-				Computed:    true,
+		},
+	}
+}
+
+func UserListResource() lSchema.Schema {
+	return lSchema.Schema{
+		Description: "Lists the users in Metabase, so that they can be imported using terraform query.",
+		Attributes: map[string]lSchema.Attribute{
+			"email_domain": lSchema.StringAttribute{
+				Description: "Only include users whose email address belongs to this domain (e.g. example.com). This is case-insensitive.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
 			},
-			"first_login": dSchema.StringAttribute{
-				Description: "The timestamp of when the user first logged into Metabase.",
-				Computed:    true,
+			"group_id": lSchema.Int64Attribute{
+				Description: "Only include users who are members of this group.",
+				Optional:    true,
 			},
-			"last_login": dSchema.StringAttribute{
-				Description: "The timestamp of the user's most recent login to Metabase.",
-				Computed:    true,
+			"status": lSchema.StringAttribute{
+				Description:         "Only include users with this status. Must be one of active, deactivated or all. Defaults to active.",
+				MarkdownDescription: "Only include users with this status. Must be one of `active`, `deactivated` or `all`. Defaults to `active`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.OneOfStringValidator(UserStatuses...),
+				},
 			},
-			"updated_at": dSchema.StringAttribute{
-				Description: "The timestamp of when the user was last updated.",
-				Computed:    true,
+			"sso_source": lSchema.StringAttribute{
+				Description:         "Only include users who log in with this SSO source (e.g. google, ldap, saml or jwt).",
+				MarkdownDescription: "Only include users who log in with this SSO source (e.g. `google`, `ldap`, `saml` or `jwt`).",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NotEmptyStringValidator(),
+				},
 			},
 		},
 	}
@@ -0,0 +1,72 @@
+package transforms
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// FromTerraformDynamic converts a Terraform value, which is usually the underlying value of a dynamic value, into the
+// equivalent value which can be marshalled to JSON. Null values are converted to nil, and unknown values are an error.
+func FromTerraformDynamic(value attr.Value) (any, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("unknown values cannot be converted")
+	}
+
+	switch v := value.(type) {
+	case basetypes.DynamicValue:
+		return FromTerraformDynamic(v.UnderlyingValue())
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.Int64Value:
+		return v.ValueInt64(), nil
+	case basetypes.Float64Value:
+		return v.ValueFloat64(), nil
+	case basetypes.NumberValue:
+		return json.Number(v.ValueBigFloat().Text('f', -1)), nil
+	case basetypes.ListValue:
+		return fromTerraformElements(v.Elements())
+	case basetypes.SetValue:
+		return fromTerraformElements(v.Elements())
+	case basetypes.TupleValue:
+		return fromTerraformElements(v.Elements())
+	case basetypes.MapValue:
+		return fromTerraformAttributes(v.Elements())
+	case basetypes.ObjectValue:
+		return fromTerraformAttributes(v.Attributes())
+	default:
+		return nil, fmt.Errorf("unsupported value type: %T", value)
+	}
+}
+
+func fromTerraformElements(elements []attr.Value) ([]any, error) {
+	result := make([]any, len(elements))
+	for i, element := range elements {
+		value, err := FromTerraformDynamic(element)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = value
+	}
+
+	return result, nil
+}
+
+func fromTerraformAttributes(attributes map[string]attr.Value) (map[string]any, error) {
+	result := make(map[string]any, len(attributes))
+	for key, attribute := range attributes {
+		value, err := FromTerraformDynamic(attribute)
+		if err != nil {
+			return nil, err
+		}
+		result[key] = value
+	}
+
+	return result, nil
+}
@@ -0,0 +1,63 @@
+package transforms
+
+import (
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestFromTerraformDynamic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("null", func(t *testing.T) {
+		result, err := FromTerraformDynamic(types.DynamicNull())
+
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := FromTerraformDynamic(types.DynamicValue(types.StringUnknown()))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("primitives", func(t *testing.T) {
+		result, err := FromTerraformDynamic(types.DynamicValue(types.StringValue("Gizmo")))
+		assert.NoError(t, err)
+		assert.Equal(t, "Gizmo", result)
+
+		result, err = FromTerraformDynamic(types.BoolValue(true))
+		assert.NoError(t, err)
+		assert.Equal(t, true, result)
+
+		result, err = FromTerraformDynamic(types.NumberValue(big.NewFloat(12.5)))
+		assert.NoError(t, err)
+		assert.Equal(t, json.Number("12.5"), result)
+	})
+
+	t.Run("nested values", func(t *testing.T) {
+		value := types.ObjectValueMust(
+			map[string]attr.Type{
+				"category": types.TupleType{ElemTypes: []attr.Type{types.StringType, types.StringType}},
+				"user_id":  types.NumberType,
+				"state":    types.StringType,
+			},
+			map[string]attr.Value{
+				"category": types.TupleValueMust([]attr.Type{types.StringType, types.StringType}, []attr.Value{types.StringValue("Gizmo"), types.StringValue("Widget")}),
+				"user_id":  types.NumberValue(big.NewFloat(1)),
+				"state":    types.StringNull(),
+			},
+		)
+
+		result, err := FromTerraformDynamic(types.DynamicValue(value))
+		assert.NoError(t, err)
+
+		encoded, err := json.Marshal(result)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"category":["Gizmo","Widget"],"user_id":1,"state":null}`, string(encoded))
+	})
+}
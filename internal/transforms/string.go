@@ -13,7 +13,7 @@ func ToTerraformString(str *string) types.String {
 }
 
 func FromTerraformString(str types.String) *string {
-	if str.IsNull() {
+	if str.IsNull() || str.IsUnknown() {
 		return nil
 	} else {
 		val := str.ValueString()
@@ -34,6 +34,12 @@ func TestFromTerraformString(t *testing.T) {
 		assert.Nil(t, str)
 	})
 
+	t.Run("unknown", func(t *testing.T) {
+		str := FromTerraformString(types.StringUnknown())
+
+		assert.Nil(t, str)
+	})
+
 	t.Run("non-nil", func(t *testing.T) {
 		str := FromTerraformString(types.StringValue("non-nil"))
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"reflect"
 )
 
 func UnmarshallJson(config types.String) (map[string]interface{}, error) {
@@ -19,3 +20,34 @@ func UnmarshallJson(config types.String) (map[string]interface{}, error) {
 		return configUnmarshalled, nil
 	}
 }
+
+// JsonEquivalent returns whether two JSON-encoded strings represent the same value, ignoring any differences in
+// formatting or key ordering. Invalid JSON is never equivalent.
+func JsonEquivalent(a string, b string) bool {
+	var aUnmarshalled, bUnmarshalled interface{}
+	if err := json.Unmarshal([]byte(a), &aUnmarshalled); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bUnmarshalled); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aUnmarshalled, bUnmarshalled)
+}
+
+// NormalizeJson returns the canonical form of a JSON-encoded string, with the object keys sorted and no insignificant
+// whitespace. This matches how the resources encode JSON attributes, so normalised values are equivalent to the values
+// read back from Metabase.
+func NormalizeJson(value string) (string, error) {
+	var unmarshalled interface{}
+	if err := json.Unmarshal([]byte(value), &unmarshalled); err != nil {
+		return "", fmt.Errorf("error unmarshalling JSON: %w", err)
+	}
+
+	normalized, err := json.Marshal(unmarshalled)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling JSON: %w", err)
+	}
+
+	return string(normalized), nil
+}
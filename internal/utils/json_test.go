@@ -45,3 +45,75 @@ func TestUnmarshallJson(t *testing.T) {
 		assert.Equal(t, float64(2), config["second"])
 	})
 }
+
+func TestJsonEquivalent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("differently formatted JSON should be equivalent", func(t *testing.T) {
+		result := JsonEquivalent(`{"first":"value","second":[1,2]}`, `
+{
+	"second": [1, 2],
+	"first": "value"
+}
+`)
+
+		assert.True(t, result)
+	})
+
+	t.Run("different values should not be equivalent", func(t *testing.T) {
+		result := JsonEquivalent(`{"first":"value"}`, `{"first":"other"}`)
+
+		assert.False(t, result)
+	})
+
+	t.Run("different array ordering should not be equivalent", func(t *testing.T) {
+		result := JsonEquivalent(`[1,2]`, `[2,1]`)
+
+		assert.False(t, result)
+	})
+
+	t.Run("invalid JSON should not be equivalent", func(t *testing.T) {
+		result := JsonEquivalent(`invalid json`, `invalid json`)
+
+		assert.False(t, result)
+	})
+}
+
+func TestNormalizeJson(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keys should be sorted and whitespace removed", func(t *testing.T) {
+		normalized, err := NormalizeJson(`{
+	"type": "native",
+	"native": {"query": "SELECT 1", "template-tags": {}},
+	"database": 1
+}`)
+
+		assert.NoError(t, err)
+		assert.Equal(t, `{"database":1,"native":{"query":"SELECT 1","template-tags":{}},"type":"native"}`, normalized)
+	})
+
+	t.Run("arrays should keep their order", func(t *testing.T) {
+		normalized, err := NormalizeJson(`[ "field", 12, null ]`)
+
+		assert.NoError(t, err)
+		assert.Equal(t, `["field",12,null]`, normalized)
+	})
+
+	t.Run("the normalised value should be equivalent to the original", func(t *testing.T) {
+		original := `{"b": [1.5, true], "a": {"d": null, "c": "value"}}`
+
+		normalized, err := NormalizeJson(original)
+
+		assert.NoError(t, err)
+		assert.Equal(t, `{"a":{"c":"value","d":null},"b":[1.5,true]}`, normalized)
+		assert.True(t, JsonEquivalent(original, normalized))
+	})
+
+	t.Run("invalid JSON should return an error", func(t *testing.T) {
+		_, err := NormalizeJson("invalid json")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "error unmarshalling JSON")
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"strconv"
 	"strings"
 )
 
@@ -21,3 +22,35 @@ func HandleResourceReadError(ctx context.Context, resourceName string, resourceI
 		}
 	}
 }
+
+// IsNumericImportId returns whether the import ID is made up only of digits, and should therefore be treated as a
+// resource ID rather than a natural key such as a name.
+func IsNumericImportId(importId string) bool {
+	if importId == "" {
+		return false
+	}
+
+	for _, r := range importId {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseImportId parses the numeric ID of a resource being imported, returning an error diagnostic describing the
+// expected format if it is not a positive integer.
+func ParseImportId(importId string, expected string) (int64, diag.Diagnostics) {
+	id, err := strconv.ParseInt(importId, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Invalid import ID",
+				fmt.Sprintf("The import ID must be %s, got: %q", expected, importId),
+			),
+		}
+	}
+
+	return id, nil
+}
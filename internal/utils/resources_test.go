@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIsNumericImportId(t *testing.T) {
+	t.Parallel()
+
+	t.Run("digits should be numeric", func(t *testing.T) {
+		assert.True(t, IsNumericImportId("123"))
+	})
+
+	t.Run("an empty string should not be numeric", func(t *testing.T) {
+		assert.False(t, IsNumericImportId(""))
+	})
+
+	t.Run("a name should not be numeric", func(t *testing.T) {
+		assert.False(t, IsNumericImportId("Analysts"))
+	})
+
+	t.Run("a signed number should not be numeric", func(t *testing.T) {
+		assert.False(t, IsNumericImportId("-1"))
+	})
+}
+
+func TestParseImportId(t *testing.T) {
+	t.Parallel()
+
+	t.Run("a positive integer should be parsed", func(t *testing.T) {
+		id, diags := ParseImportId("42", "a numeric ID")
+
+		assert.Empty(t, diags)
+		assert.Equal(t, int64(42), id)
+	})
+
+	t.Run("a malformed ID should return an error", func(t *testing.T) {
+		_, diags := ParseImportId("4x2", "a numeric ID")
+
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Invalid import ID", diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), "a numeric ID")
+		assert.Contains(t, diags[0].Detail(), `"4x2"`)
+	})
+
+	t.Run("zero should return an error", func(t *testing.T) {
+		_, diags := ParseImportId("0", "a numeric ID")
+
+		assert.True(t, diags.HasError())
+	})
+
+	t.Run("an ID that overflows should return an error", func(t *testing.T) {
+		_, diags := ParseImportId("99999999999999999999", "a numeric ID")
+
+		assert.True(t, diags.HasError())
+	})
+}
@@ -0,0 +1,24 @@
+package utils
+
+import "strings"
+
+// NormaliseWhitespace normalises the line endings, removes any trailing whitespace from each line and trims any leading
+// or trailing blank lines, so that values which only differ in formatting can be compared. Whitespace within each line
+// is kept, as it may be significant (eg in SQL string literals).
+func NormaliseWhitespace(value string) string {
+	value = strings.ReplaceAll(value, "\r\n", "\n")
+	value = strings.ReplaceAll(value, "\r", "\n")
+
+	lines := strings.Split(value, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
+// WhitespaceEquivalent returns whether two strings are the same, ignoring any differences in line endings, trailing
+// whitespace and leading or trailing blank lines.
+func WhitespaceEquivalent(a string, b string) bool {
+	return NormaliseWhitespace(a) == NormaliseWhitespace(b)
+}
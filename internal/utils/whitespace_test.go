@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNormaliseWhitespace(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "  SELECT *\n\tFROM orders\n  WHERE id = 1", NormaliseWhitespace("\n  SELECT *  \n\tFROM orders\r\n  WHERE id = 1  \n\n"))
+	assert.Equal(t, "SELECT 1\n\nFROM orders", NormaliseWhitespace("SELECT 1\r\n \t\r\nFROM orders"))
+	assert.Equal(t, "", NormaliseWhitespace(" \n\t "))
+}
+
+func TestWhitespaceEquivalent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("differences in line endings and trailing whitespace should be equivalent", func(t *testing.T) {
+		assert.True(t, WhitespaceEquivalent("status = 'active'\nAND archived = false", "\nstatus = 'active'  \r\nAND archived = false\n"))
+	})
+
+	t.Run("different content should not be equivalent", func(t *testing.T) {
+		assert.False(t, WhitespaceEquivalent("status = 'active'", "status = 'inactive'"))
+	})
+
+	t.Run("removed whitespace should not be equivalent", func(t *testing.T) {
+		assert.False(t, WhitespaceEquivalent("a b", "ab"))
+	})
+
+	t.Run("whitespace within a line should not be collapsed", func(t *testing.T) {
+		assert.False(t, WhitespaceEquivalent("name = 'a  b'", "name = 'a b'"))
+	})
+
+	t.Run("changes to indentation should not be equivalent", func(t *testing.T) {
+		assert.False(t, WhitespaceEquivalent("SELECT 1\n  FROM orders", "SELECT 1\nFROM orders"))
+	})
+}
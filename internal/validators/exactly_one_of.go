@@ -0,0 +1,77 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"strings"
+)
+
+type exactlyOneOfValidator struct {
+	attributes []string
+}
+
+// ExactlyOneOfValidator validates that exactly one of the given top-level attributes is configured. It can be used as
+// either a data source or resource config validator.
+func ExactlyOneOfValidator(attributes ...string) exactlyOneOfValidator {
+	return exactlyOneOfValidator{
+		attributes: attributes,
+	}
+}
+
+func (v exactlyOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("exactly one of these attributes must be configured: %s", strings.Join(v.attributes, ", "))
+}
+
+func (v exactlyOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v exactlyOneOfValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+func (v exactlyOneOfValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+func (v exactlyOneOfValidator) validate(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configured := make([]string, 0)
+	for _, attribute := range v.attributes {
+		var value attr.Value
+		diags.Append(config.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		// We can't know whether an unknown value will be null, so defer validation until it is known
+		if value.IsUnknown() {
+			return diags
+		}
+		if !value.IsNull() {
+			configured = append(configured, attribute)
+		}
+	}
+
+	if len(configured) == 0 {
+		diags.AddError(
+			"Missing attribute configuration",
+			fmt.Sprintf("Exactly one of these attributes must be configured: %s.", strings.Join(v.attributes, ", ")),
+		)
+	} else if len(configured) > 1 {
+		diags.AddAttributeError(
+			path.Root(configured[1]),
+			"Invalid attribute combination",
+			fmt.Sprintf("Only one of these attributes can be configured: %s.", strings.Join(v.attributes, ", ")),
+		)
+	}
+
+	return diags
+}
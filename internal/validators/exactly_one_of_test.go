@@ -0,0 +1,87 @@
+package validators
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestExactlyOneOfValidator(t *testing.T) {
+	t.Parallel()
+
+	exactlyOneOfValidator := ExactlyOneOfValidator("id", "email")
+	ctx := context.Background()
+
+	testSchema := dSchema.Schema{
+		Attributes: map[string]dSchema.Attribute{
+			"id":    dSchema.Int64Attribute{Optional: true},
+			"email": dSchema.StringAttribute{Optional: true},
+		},
+	}
+	buildRequest := func(id tftypes.Value, email tftypes.Value) datasource.ValidateConfigRequest {
+		return datasource.ValidateConfigRequest{
+			Config: tfsdk.Config{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id":    tftypes.Number,
+						"email": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"id":    id,
+					"email": email,
+				}),
+			},
+		}
+	}
+
+	t.Run("description", func(t *testing.T) {
+		assert.Contains(t, exactlyOneOfValidator.Description(ctx), "id, email")
+	})
+
+	t.Run("markdown description", func(t *testing.T) {
+		assert.NotEmpty(t, exactlyOneOfValidator.MarkdownDescription(ctx))
+	})
+
+	t.Run("neither attribute being configured should return an error", func(t *testing.T) {
+		request := buildRequest(tftypes.NewValue(tftypes.Number, nil), tftypes.NewValue(tftypes.String, nil))
+		response := datasource.ValidateConfigResponse{}
+
+		exactlyOneOfValidator.ValidateDataSource(ctx, request, &response)
+
+		assert.NotEmpty(t, response.Diagnostics)
+		assert.Equal(t, "Missing attribute configuration", response.Diagnostics[0].Summary())
+	})
+
+	t.Run("both attributes being configured should return an error", func(t *testing.T) {
+		request := buildRequest(tftypes.NewValue(tftypes.Number, 1), tftypes.NewValue(tftypes.String, "example@example.com"))
+		response := datasource.ValidateConfigResponse{}
+
+		exactlyOneOfValidator.ValidateDataSource(ctx, request, &response)
+
+		assert.NotEmpty(t, response.Diagnostics)
+		assert.Equal(t, "Invalid attribute combination", response.Diagnostics[0].Summary())
+	})
+
+	t.Run("one attribute being configured should pass", func(t *testing.T) {
+		request := buildRequest(tftypes.NewValue(tftypes.Number, nil), tftypes.NewValue(tftypes.String, "example@example.com"))
+		response := datasource.ValidateConfigResponse{}
+
+		exactlyOneOfValidator.ValidateDataSource(ctx, request, &response)
+
+		assert.Empty(t, response.Diagnostics)
+	})
+
+	t.Run("an unknown attribute should pass", func(t *testing.T) {
+		request := buildRequest(tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, "example@example.com"))
+		response := datasource.ValidateConfigResponse{}
+
+		exactlyOneOfValidator.ValidateDataSource(ctx, request, &response)
+
+		assert.Empty(t, response.Diagnostics)
+	})
+}
@@ -0,0 +1,51 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+	"strings"
+)
+
+type oneOfStringValidator struct {
+	validator.String
+	allowed []string
+}
+
+func OneOfStringValidator(allowed ...string) validator.String {
+	return oneOfStringValidator{
+		allowed: allowed,
+	}
+}
+
+func (v oneOfStringValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.allowed, ", "))
+}
+
+func (v oneOfStringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v oneOfStringValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	var str types.String
+	diags := tfsdk.ValueAs(ctx, req.ConfigValue, &str)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if str.IsUnknown() || str.IsNull() {
+		return
+	}
+
+	if !slices.Contains(v.allowed, str.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value",
+			fmt.Sprintf("Value '%s' is not valid. It must be one of: %s.", str.ValueString(), strings.Join(v.allowed, ", ")),
+		)
+	}
+}
@@ -0,0 +1,62 @@
+package validators
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOneOfStringValidator(t *testing.T) {
+	t.Parallel()
+
+	oneOfValidator := OneOfStringValidator("first", "second")
+	ctx := context.Background()
+
+	t.Run("description", func(t *testing.T) {
+		assert.Contains(t, oneOfValidator.Description(ctx), "first, second")
+	})
+
+	t.Run("markdown description", func(t *testing.T) {
+		assert.NotEmpty(t, oneOfValidator.MarkdownDescription(ctx))
+	})
+
+	t.Run("a value not in the list should return an error", func(t *testing.T) {
+		request := validator.StringRequest{
+			Path:        path.Empty(),
+			ConfigValue: types.StringValue("third"),
+		}
+		response := validator.StringResponse{}
+
+		oneOfValidator.ValidateString(ctx, request, &response)
+
+		assert.NotEmpty(t, response.Diagnostics)
+		assert.Equal(t, "Invalid value", response.Diagnostics[0].Summary())
+	})
+
+	t.Run("a value in the list should pass", func(t *testing.T) {
+		request := validator.StringRequest{
+			Path:        path.Empty(),
+			ConfigValue: types.StringValue("second"),
+		}
+		response := validator.StringResponse{}
+
+		oneOfValidator.ValidateString(ctx, request, &response)
+
+		assert.Empty(t, response.Diagnostics)
+	})
+
+	t.Run("a nil string should pass", func(t *testing.T) {
+		request := validator.StringRequest{
+			Path:        path.Empty(),
+			ConfigValue: types.StringNull(),
+		}
+		response := validator.StringResponse{}
+
+		oneOfValidator.ValidateString(ctx, request, &response)
+
+		assert.Empty(t, response.Diagnostics)
+	})
+}
@@ -0,0 +1,93 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"github.com/bnjns/metabase-sdk-go/service/database"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type scheduleValidator struct {
+	validator.Object
+}
+
+// ScheduleValidator validates that the hour, day and frame of a schedule are consistent with its type, as Metabase
+// ignores or rejects the attributes which don't apply.
+func ScheduleValidator() validator.Object {
+	return scheduleValidator{}
+}
+
+func (v scheduleValidator) Description(ctx context.Context) string {
+	return "hour, day and frame must be consistent with the schedule type"
+}
+
+func (v scheduleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v scheduleValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	attributes := req.ConfigValue.Attributes()
+	scheduleType, ok := attributes["type"].(types.String)
+	if !ok || scheduleType.IsUnknown() || scheduleType.IsNull() {
+		return
+	}
+
+	var required, forbidden []string
+	switch database.ScheduleType(scheduleType.ValueString()) {
+	case database.ScheduleTypeHourly:
+		forbidden = []string{"hour", "day", "frame"}
+	case database.ScheduleTypeDaily:
+		required = []string{"hour"}
+		forbidden = []string{"day", "frame"}
+	case database.ScheduleTypeWeekly:
+		required = []string{"hour", "day"}
+		forbidden = []string{"frame"}
+	case database.ScheduleTypeMonthly:
+		required = []string{"hour", "frame"}
+		if frame, ok := attributes["frame"].(types.String); ok && frame.ValueString() == string(database.ScheduleFrameTypeMid) {
+			forbidden = []string{"day"}
+		}
+	default:
+		return
+	}
+
+	for _, name := range required {
+		if isNull(attributes[name]) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtName(name),
+				"Missing schedule attribute",
+				fmt.Sprintf("The %s must be set when the schedule type is %s.", name, scheduleType.ValueString()),
+			)
+		}
+	}
+	for _, name := range forbidden {
+		if !isNull(attributes[name]) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtName(name),
+				"Invalid schedule attribute",
+				fmt.Sprintf("The %s cannot be set when the schedule type is %s.", name, scheduleType.ValueString()),
+			)
+		}
+	}
+
+	if hour, ok := attributes["hour"].(types.Int64); ok && !hour.IsNull() && !hour.IsUnknown() {
+		if hour.ValueInt64() < 0 || hour.ValueInt64() > 23 {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtName("hour"),
+				"Invalid schedule hour",
+				fmt.Sprintf("The hour must be between 0 and 23, got: %d.", hour.ValueInt64()),
+			)
+		}
+	}
+}
+
+// isNull returns whether an attribute is definitely null, treating unknown values as set.
+func isNull(value attr.Value) bool {
+	return value == nil || value.IsNull()
+}
@@ -0,0 +1,109 @@
+package validators
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var scheduleAttributeTypes = map[string]attr.Type{
+	"type":  types.StringType,
+	"hour":  types.Int64Type,
+	"day":   types.StringType,
+	"frame": types.StringType,
+}
+
+func buildSchedule(scheduleType string, hour *int64, day *string, frame *string) types.Object {
+	return types.ObjectValueMust(scheduleAttributeTypes, map[string]attr.Value{
+		"type":  types.StringValue(scheduleType),
+		"hour":  types.Int64PointerValue(hour),
+		"day":   types.StringPointerValue(day),
+		"frame": types.StringPointerValue(frame),
+	})
+}
+
+func TestScheduleValidator(t *testing.T) {
+	t.Parallel()
+
+	scheduleValidator := ScheduleValidator()
+	ctx := context.Background()
+	hour := int64(9)
+	invalidHour := int64(24)
+	day := "mon"
+	first := "first"
+	mid := "mid"
+
+	validate := func(value types.Object) validator.ObjectResponse {
+		response := validator.ObjectResponse{}
+		scheduleValidator.ValidateObject(ctx, validator.ObjectRequest{
+			Path:        path.Root("schedule"),
+			ConfigValue: value,
+		}, &response)
+		return response
+	}
+
+	t.Run("description", func(t *testing.T) {
+		assert.NotEmpty(t, scheduleValidator.Description(ctx))
+		assert.NotEmpty(t, scheduleValidator.MarkdownDescription(ctx))
+	})
+
+	t.Run("a null schedule should pass", func(t *testing.T) {
+		response := validate(types.ObjectNull(scheduleAttributeTypes))
+
+		assert.Empty(t, response.Diagnostics)
+	})
+
+	t.Run("consistent schedules should pass", func(t *testing.T) {
+		schedules := []types.Object{
+			buildSchedule("hourly", nil, nil, nil),
+			buildSchedule("daily", &hour, nil, nil),
+			buildSchedule("weekly", &hour, &day, nil),
+			buildSchedule("monthly", &hour, nil, &mid),
+			buildSchedule("monthly", &hour, &day, &first),
+		}
+
+		for _, schedule := range schedules {
+			response := validate(schedule)
+			assert.Empty(t, response.Diagnostics, schedule.String())
+		}
+	})
+
+	t.Run("an hourly schedule should not have an hour", func(t *testing.T) {
+		response := validate(buildSchedule("hourly", &hour, nil, nil))
+
+		assert.Len(t, response.Diagnostics, 1)
+		assert.Equal(t, "Invalid schedule attribute", response.Diagnostics[0].Summary())
+	})
+
+	t.Run("a weekly schedule must have a day", func(t *testing.T) {
+		response := validate(buildSchedule("weekly", &hour, nil, nil))
+
+		assert.Len(t, response.Diagnostics, 1)
+		assert.Equal(t, "Missing schedule attribute", response.Diagnostics[0].Summary())
+	})
+
+	t.Run("a monthly schedule must have a frame", func(t *testing.T) {
+		response := validate(buildSchedule("monthly", &hour, nil, nil))
+
+		assert.Len(t, response.Diagnostics, 1)
+		assert.Equal(t, "Missing schedule attribute", response.Diagnostics[0].Summary())
+	})
+
+	t.Run("a day cannot be used in the middle of the month", func(t *testing.T) {
+		response := validate(buildSchedule("monthly", &hour, &day, &mid))
+
+		assert.Len(t, response.Diagnostics, 1)
+		assert.Equal(t, "Invalid schedule attribute", response.Diagnostics[0].Summary())
+	})
+
+	t.Run("the hour must be a valid hour of the day", func(t *testing.T) {
+		response := validate(buildSchedule("daily", &invalidHour, nil, nil))
+
+		assert.Len(t, response.Diagnostics, 1)
+		assert.Equal(t, "Invalid schedule hour", response.Diagnostics[0].Summary())
+	})
+}
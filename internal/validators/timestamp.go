@@ -0,0 +1,48 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"time"
+)
+
+type timestampValidator struct {
+	validator.String
+}
+
+// TimestampValidator validates that a string is an RFC 3339 timestamp, eg 2024-01-01T09:00:00Z.
+func TimestampValidator() validator.String {
+	return timestampValidator{}
+}
+
+func (v timestampValidator) Description(ctx context.Context) string {
+	return "value must be an RFC 3339 timestamp"
+}
+
+func (v timestampValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timestampValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	var str types.String
+	diags := tfsdk.ValueAs(ctx, req.ConfigValue, &str)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if str.IsUnknown() || str.IsNull() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, str.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid timestamp",
+			fmt.Sprintf("Value '%s' is not an RFC 3339 timestamp (eg, 2024-01-01T09:00:00Z).", str.ValueString()),
+		)
+	}
+}
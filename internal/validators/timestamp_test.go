@@ -0,0 +1,47 @@
+package validators
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTimestampValidator(t *testing.T) {
+	t.Parallel()
+
+	timestampValidator := TimestampValidator()
+	ctx := context.Background()
+
+	validate := func(value types.String) validator.StringResponse {
+		response := validator.StringResponse{}
+		timestampValidator.ValidateString(ctx, validator.StringRequest{
+			Path:        path.Empty(),
+			ConfigValue: value,
+		}, &response)
+		return response
+	}
+
+	t.Run("description", func(t *testing.T) {
+		assert.NotEmpty(t, timestampValidator.Description(ctx))
+		assert.NotEmpty(t, timestampValidator.MarkdownDescription(ctx))
+	})
+
+	t.Run("an RFC 3339 timestamp should pass", func(t *testing.T) {
+		assert.Empty(t, validate(types.StringValue("2024-01-01T09:00:00Z")).Diagnostics)
+		assert.Empty(t, validate(types.StringValue("2024-01-01T09:00:00.123+01:00")).Diagnostics)
+	})
+
+	t.Run("a date without a time should return an error", func(t *testing.T) {
+		response := validate(types.StringValue("2024-01-01"))
+
+		assert.NotEmpty(t, response.Diagnostics)
+		assert.Equal(t, "Invalid timestamp", response.Diagnostics[0].Summary())
+	})
+
+	t.Run("a null value should pass", func(t *testing.T) {
+		assert.Empty(t, validate(types.StringNull()).Diagnostics)
+	})
+}
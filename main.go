@@ -4,7 +4,9 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
+	"terraform-provider-metabase/internal/generate"
 	"terraform-provider-metabase/internal/provider"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -30,6 +32,14 @@ var (
 )
 
 func main() {
+	// The generate subcommand writes the configuration for an existing Metabase instance, rather than serving the plugin
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := generate.Run(context.Background(), os.Args[2:], os.Stderr); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")